@@ -14,44 +14,95 @@ import (
 
 // API maps all Telegram Bot api methods
 type API interface {
-	AnswerCallbackQuery(query, text string, alert bool) error
+	AnswerCallbackQuery(query, text string, alert bool, opts *CallbackQueryOptions) error
 	AnswerInlineQuery(query string, results []InlineQueryResult, opts *InlineQueryOptions) error
-	EditCaption(chat string, msg int, caption, mode string, noPreview bool, markup ReplyMarkup) (*Message, error)
+	AnswerPreCheckoutQuery(query string, ok bool, errMsg string) error
+	AnswerShippingQuery(query string, ok bool, options []ShippingOption, errMsg string) error
+	ApproveChatJoinRequest(chat ChatID, user int64) error
+	BanChatMember(chat ChatID, user, until int64, revoke bool) error
+	BanChatSenderChat(chat ChatID, sender int64) error
+	CreateChatInviteLink(chat ChatID, opts *InviteLinkOptions) (*ChatInviteLink, error)
+	CreateInvoiceLink(title, desc, payload, provider, currency string, prices []LabeledPrice, invOpts *InvoiceOptions) (string, error)
+	DeclineChatJoinRequest(chat ChatID, user int64) error
+	DeleteChatPhoto(chat ChatID) error
+	DeleteMyCommands(scope *BotCommandScope, lang string) error
+	EditCaption(chat ChatID, msg int64, caption, mode string, noPreview bool, markup ReplyMarkup) (*Message, error)
+	EditChatInviteLink(chat ChatID, link string, opts *InviteLinkOptions) (*ChatInviteLink, error)
 	EditInlineCaption(msg, caption, mode string, noPreview bool, markup ReplyMarkup) (*Message, error)
+	EditInlineLiveLocation(msg string, lat, lng float64, markup ReplyMarkup) (*Message, error)
 	EditInlineMarkup(msg string, markup ReplyMarkup) (*Message, error)
 	EditInlineText(msg, text, mode string, noPreview bool, markup ReplyMarkup) (*Message, error)
-	EditMarkup(chat string, msg int, markup ReplyMarkup) (*Message, error)
-	EditText(chat string, msg int, text, mode string, noPreview bool, markup ReplyMarkup) (*Message, error)
-	ForwardMessage(to, from string, silent bool, message int) (*Message, error)
-	GetChat(chat string) (*Victim, error)
-	GetChatAdministrators(chat string) ([]ChatMember, error)
-	GetChatMember(chat string) (*ChatMember, error)
-	GetChatMembersCount(chat string) (int, error)
+	EditLiveLocation(chat ChatID, msg int64, lat, lng float64, markup ReplyMarkup) (*Message, error)
+	EditMarkup(chat ChatID, msg int64, markup ReplyMarkup) (*Message, error)
+	EditText(chat ChatID, msg int64, text, mode string, noPreview bool, markup ReplyMarkup) (*Message, error)
+	ExportChatInviteLink(chat ChatID) (string, error)
+	ForwardMessage(to, from ChatID, silent bool, message int64) (*Message, error)
+	GetChat(chat ChatID) (*ChatFullInfo, error)
+	GetChatAdministrators(chat ChatID) ([]ChatMember, error)
+	GetChatMember(chat ChatID, user int64) (*ChatMember, error)
+	GetChatMembersCount(chat ChatID) (int, error)
+	GetChatMenuButton(chat ChatID) (*MenuButton, error)
 	GetFile(file string) (*File, error)
-	GetMe() (*Victim, error)
-	GetUpdates(offset, limit, timeout int) ([]Update, error)
-	GetUserProfilePhotos(user, offset, limit int) (*UserProfilePhotos, error)
-	KickChatMember(chat string, user int) error
-	LeaveChat(chat string) error
-	SendAudio(chat, audio string, duration int, performer, title string, opts *Options) (*Message, error)
-	SendChatAction(chat, action string) error
-	SendContact(chat, phone, firstName, lastName string, opts *Options) (*Message, error)
-	SendDocument(chat, document, caption string, opts *Options) (*Message, error)
-	SendLocation(chat string, lat, lng float64, opts *Options) (*Message, error)
-	SendMessage(chat, text string, opts *Options) (*Message, error)
-	SendPhoto(chat, photo, caption string, opts *Options) (*Message, error)
-	SendSticker(chat, sticker, caption string, opts *Options) (*Message, error)
-	SendVenue(chat string, lat, lng float64, title, addr, foursq string, opts *Options) (*Message, error)
-	SendVideo(chat, video string, duration, width, height int, caption string, opts *Options) (*Message, error)
-	SendVoice(chat, voice string, duration int, opts *Options) (*Message, error)
+	GetGameHighScores(chat ChatID, msg, user int64) ([]GameHighScore, error)
+	GetInlineGameHighScores(msg string, user int64) ([]GameHighScore, error)
+	GetMe() (*User, error)
+	GetMyCommands(scope *BotCommandScope, lang string) ([]BotCommand, error)
+	GetMyDefaultAdministratorRights(channels bool) (*ChatAdministratorRights, error)
+	GetMyDescription(lang string) (string, error)
+	GetMyName(lang string) (string, error)
+	GetMyShortDescription(lang string) (string, error)
+	GetUpdates(offset int64, limit, timeout int, allowed []string) ([]Update, error)
+	GetUserProfilePhotos(user int64, offset, limit int) (*UserProfilePhotos, error)
+	KickChatMember(chat ChatID, user int64) error
+	LeaveChat(chat ChatID) error
+	PromoteChatMember(chat ChatID, user int64, rights ChatAdministratorRights) error
+	RestrictChatMember(chat ChatID, user int64, perms ChatPermissions, until int64) error
+	RevokeChatInviteLink(chat ChatID, link string) (*ChatInviteLink, error)
+	SendAnimation(chat ChatID, animation string, duration, width, height int, caption string, opts *Options) (*Message, error)
+	SendAudio(chat ChatID, audio string, duration int, performer, title string, opts *Options) (*Message, error)
+	SendChatAction(chat ChatID, action string) error
+	SendContact(chat ChatID, phone, firstName, lastName string, opts *Options) (*Message, error)
+	SendDice(chat ChatID, emoji string, opts *Options) (*Message, error)
+	SendDocument(chat ChatID, document, caption string, opts *Options) (*Message, error)
+	SendGame(chat ChatID, game string, opts *Options) (*Message, error)
+	SendInvoice(chat ChatID, title, desc, payload, provider, currency string, prices []LabeledPrice, invOpts *InvoiceOptions, opts *Options) (*Message, error)
+	SendLiveLocation(chat ChatID, lat, lng float64, livePeriod int, opts *Options) (*Message, error)
+	SendLocation(chat ChatID, lat, lng float64, opts *Options) (*Message, error)
+	SendMessage(chat ChatID, text string, opts *Options) (*Message, error)
+	SendPhoto(chat ChatID, photo, caption string, opts *Options) (*Message, error)
+	SendPoll(chat ChatID, question string, answers []string, pollOpts *PollOptions, opts *Options) (*Message, error)
+	SendSticker(chat ChatID, sticker, caption string, opts *Options) (*Message, error)
+	SendVenue(chat ChatID, lat, lng float64, title, addr, foursq string, opts *Options) (*Message, error)
+	SendVideo(chat ChatID, video string, duration, width, height int, caption string, opts *Options) (*Message, error)
+	SendVideoNote(chat ChatID, videoNote string, duration, length int, opts *Options) (*Message, error)
+	SendVoice(chat ChatID, voice string, duration int, opts *Options) (*Message, error)
+	SetChatAdministratorCustomTitle(chat ChatID, user int64, title string) error
+	SetChatDescription(chat ChatID, desc string) error
+	SetChatMenuButton(chat ChatID, button *MenuButton) error
+	SetChatPermissions(chat ChatID, perms ChatPermissions) error
+	SetChatPhoto(chat ChatID, photo io.Reader) error
+	SetChatTitle(chat ChatID, title string) error
+	SetGameScore(chat ChatID, msg, user int64, score int, force, noEdit bool) (*Message, error)
+	SetInlineGameScore(msg string, user int64, score int, force, noEdit bool) (*Message, error)
+	SetMyCommands(commands []BotCommand, scope *BotCommandScope, lang string) error
+	SetMyDefaultAdministratorRights(rights *ChatAdministratorRights, channels bool) error
+	SetMyDescription(desc, lang string) error
+	SetMyName(name, lang string) error
+	SetMyShortDescription(desc, lang string) error
 	SetWebhook(cb string, certificate io.Reader) error
-	UnbanChatMember(chat string, user int) error
-	UploadAudio(chat string, audio io.Reader, duration int, performer, title string, opts *Options) (*Message, error)
-	UploadDocument(chat string, document io.Reader, caption string, opts *Options) (*Message, error)
-	UploadPhoto(chat string, photo io.Reader, caption string, opts *Options) (*Message, error)
-	UploadSticker(chat string, sticker io.Reader, caption string, opts *Options) (*Message, error)
-	UploadVideo(chat string, video io.Reader, duration, width, height int, caption string, opts *Options) (*Message, error)
-	UploadVoice(chat string, voice io.Reader, duration int, opts *Options) (*Message, error)
+	StopInlineLiveLocation(msg string, markup ReplyMarkup) (*Message, error)
+	StopLiveLocation(chat ChatID, msg int64, markup ReplyMarkup) (*Message, error)
+	StopPoll(chat ChatID, msg int64, markup ReplyMarkup) (*Poll, error)
+	UnbanChatMember(chat ChatID, user int64) error
+	UnbanChatSenderChat(chat ChatID, sender int64) error
+	UploadAnimation(chat ChatID, animation io.Reader, duration, width, height int, caption string, opts *Options) (*Message, error)
+	UploadAudio(chat ChatID, audio io.Reader, duration int, performer, title string, opts *Options) (*Message, error)
+	UploadDocument(chat ChatID, document io.Reader, caption string, opts *Options) (*Message, error)
+	UploadPhoto(chat ChatID, photo io.Reader, caption string, opts *Options) (*Message, error)
+	UploadSticker(chat ChatID, sticker io.Reader, caption string, opts *Options) (*Message, error)
+	UploadVideo(chat ChatID, video io.Reader, duration, width, height int, caption string, opts *Options) (*Message, error)
+	UploadVideoNote(chat ChatID, videoNote io.Reader, duration, length int, opts *Options) (*Message, error)
+	UploadVoice(chat ChatID, voice io.Reader, duration int, opts *Options) (*Message, error)
 }
 
 type api struct {
@@ -0,0 +1,192 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+func optScope(params url.Values, scope *BotCommandScope, lang string) error {
+	if scope != nil {
+		buf, err := json.Marshal(scope)
+		if err != nil {
+			return err
+		}
+		params.Set("scope", string(buf))
+	}
+	optStr(params, "language_code", lang)
+	return nil
+}
+
+// SetMyCommands maps to https://core.telegram.org/bots/api#setmycommands
+//
+// Pass nil scope to use ScopeDefault, empty lang to apply to all users without dedicated commands.
+func (a *api) SetMyCommands(commands []BotCommand, scope *BotCommandScope, lang string) error {
+	params := url.Values{}
+
+	buf, err := json.Marshal(commands)
+	if err != nil {
+		return err
+	}
+	params.Set("commands", string(buf))
+	if err := optScope(params, scope, lang); err != nil {
+		return err
+	}
+
+	return a.callAndSet("setMyCommands", params, nil)
+}
+
+// GetMyCommands maps to https://core.telegram.org/bots/api#getmycommands
+func (a *api) GetMyCommands(scope *BotCommandScope, lang string) ([]BotCommand, error) {
+	params := url.Values{}
+
+	if err := optScope(params, scope, lang); err != nil {
+		return nil, err
+	}
+
+	var r commandsResult
+	err := a.callAndSet("getMyCommands", params, &r)
+	return r.Commands, err
+}
+
+// DeleteMyCommands maps to https://core.telegram.org/bots/api#deletemycommands
+func (a *api) DeleteMyCommands(scope *BotCommandScope, lang string) error {
+	params := url.Values{}
+
+	if err := optScope(params, scope, lang); err != nil {
+		return err
+	}
+
+	return a.callAndSet("deleteMyCommands", params, nil)
+}
+
+// SetMyName maps to https://core.telegram.org/bots/api#setmyname
+//
+// Pass empty name to remove the dedicated name for lang.
+func (a *api) SetMyName(name, lang string) error {
+	params := url.Values{}
+
+	optStr(params, "name", name)
+	optStr(params, "language_code", lang)
+
+	return a.callAndSet("setMyName", params, nil)
+}
+
+// GetMyName maps to https://core.telegram.org/bots/api#getmyname
+func (a *api) GetMyName(lang string) (string, error) {
+	params := url.Values{}
+
+	optStr(params, "language_code", lang)
+
+	var r botInfoResult
+	err := a.callAndSet("getMyName", params, &r)
+	return r.Info.Name, err
+}
+
+// SetMyDescription maps to https://core.telegram.org/bots/api#setmydescription
+//
+// Pass empty desc to remove the dedicated description for lang.
+func (a *api) SetMyDescription(desc, lang string) error {
+	params := url.Values{}
+
+	optStr(params, "description", desc)
+	optStr(params, "language_code", lang)
+
+	return a.callAndSet("setMyDescription", params, nil)
+}
+
+// GetMyDescription maps to https://core.telegram.org/bots/api#getmydescription
+func (a *api) GetMyDescription(lang string) (string, error) {
+	params := url.Values{}
+
+	optStr(params, "language_code", lang)
+
+	var r botInfoResult
+	err := a.callAndSet("getMyDescription", params, &r)
+	return r.Info.Description, err
+}
+
+// SetMyShortDescription maps to https://core.telegram.org/bots/api#setmyshortdescription
+//
+// Pass empty desc to remove the dedicated short description for lang.
+func (a *api) SetMyShortDescription(desc, lang string) error {
+	params := url.Values{}
+
+	optStr(params, "short_description", desc)
+	optStr(params, "language_code", lang)
+
+	return a.callAndSet("setMyShortDescription", params, nil)
+}
+
+// GetMyShortDescription maps to https://core.telegram.org/bots/api#getmyshortdescription
+func (a *api) GetMyShortDescription(lang string) (string, error) {
+	params := url.Values{}
+
+	optStr(params, "language_code", lang)
+
+	var r botInfoResult
+	err := a.callAndSet("getMyShortDescription", params, &r)
+	return r.Info.ShortDescription, err
+}
+
+// SetChatMenuButton maps to https://core.telegram.org/bots/api#setchatmenubutton
+//
+// Pass empty chat to change the default menu button, nil button to reset to MenuButtonDefault.
+func (a *api) SetChatMenuButton(chat ChatID, button *MenuButton) error {
+	params := url.Values{}
+
+	optStr(params, "chat_id", string(chat))
+	if button != nil {
+		buf, err := json.Marshal(button)
+		if err != nil {
+			return err
+		}
+		params.Set("menu_button", string(buf))
+	}
+
+	return a.callAndSet("setChatMenuButton", params, nil)
+}
+
+// GetChatMenuButton maps to https://core.telegram.org/bots/api#getchatmenubutton
+//
+// Pass empty chat to get the default menu button.
+func (a *api) GetChatMenuButton(chat ChatID) (*MenuButton, error) {
+	params := url.Values{}
+
+	optStr(params, "chat_id", string(chat))
+
+	var r menuButtonResult
+	err := a.callAndSet("getChatMenuButton", params, &r)
+	return r.Button, err
+}
+
+// SetMyDefaultAdministratorRights maps to https://core.telegram.org/bots/api#setmydefaultadministratorrights
+//
+// Pass nil rights to clear the default rights. Set channels to change the rights for channels instead of groups.
+func (a *api) SetMyDefaultAdministratorRights(rights *ChatAdministratorRights, channels bool) error {
+	params := url.Values{}
+
+	if rights != nil {
+		buf, err := json.Marshal(rights)
+		if err != nil {
+			return err
+		}
+		params.Set("rights", string(buf))
+	}
+	optBool(params, "for_channels", channels)
+
+	return a.callAndSet("setMyDefaultAdministratorRights", params, nil)
+}
+
+// GetMyDefaultAdministratorRights maps to https://core.telegram.org/bots/api#getmydefaultadministratorrights
+func (a *api) GetMyDefaultAdministratorRights(channels bool) (*ChatAdministratorRights, error) {
+	params := url.Values{}
+
+	optBool(params, "for_channels", channels)
+
+	var r rightsResult
+	err := a.callAndSet("getMyDefaultAdministratorRights", params, &r)
+	return r.Rights, err
+}
@@ -0,0 +1,352 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// errors returned by CallbackCodec
+var (
+	ErrCallbackDataTooLong   = errors.New("callback data exceeds 64 bytes")
+	ErrMalformedCallbackData = errors.New("malformed callback data")
+	ErrInvalidSignature      = errors.New("invalid callback data signature")
+)
+
+// CallbackStore persists payloads too large for callback data, see CallbackCodec
+//
+// Load returns nil if key is not found.
+type CallbackStore interface {
+	Load(key string) (payload []byte, err error)
+	Store(key string, payload []byte) error
+}
+
+// MemoryCallbackStore is a CallbackStore keeps payloads in memory.
+//
+// Oldest payloads are evicted when there are more than Max payloads, buttons referring
+// to evicted payloads fail to decode. Payloads are lost when the process exits, use
+// persistent store if buttons should keep working across restarts.
+type MemoryCallbackStore struct {
+	Max      int // defaults to 1024
+	lock     sync.RWMutex
+	payloads map[string][]byte
+	keys     []string // in insertion order
+}
+
+// Load implements CallbackStore
+func (s *MemoryCallbackStore) Load(key string) ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.payloads[key], nil
+}
+
+// Store implements CallbackStore
+func (s *MemoryCallbackStore) Store(key string, payload []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.payloads == nil {
+		s.payloads = map[string][]byte{}
+	}
+	if _, ok := s.payloads[key]; !ok {
+		s.keys = append(s.keys, key)
+	}
+	s.payloads[key] = payload
+
+	max := s.Max
+	if max <= 0 {
+		max = 1024
+	}
+	for len(s.keys) > max {
+		delete(s.payloads, s.keys[0])
+		s.keys = s.keys[1:]
+	}
+	return nil
+}
+
+// modes of encoded payload
+const (
+	inlinePayload byte = iota
+	storedPayload
+)
+
+// length of the key of stored payloads, in bytes
+const callbackStoreKeyLength = 12
+
+// shortest signature accepted, in bytes, shorter ones are too easy to guess
+const minSigLength = 4
+
+// CallbackCodec packs an action name and a small struct into callback data.
+//
+// Encoded data is formatted as "action:base64", so it can be dispatched by
+// Router.HandleCallbackQuery with the action name. The struct is encoded in a compact
+// binary format, supported field types are bool, integers, floats, string, []byte and
+// nested structs. Unexported fields are ignored.
+//
+// Payloads are signed with HMAC-SHA256 if Key is set, Decode rejects data with invalid
+// signature, so forged callback queries are detected. Payloads too large for callback
+// data are kept in Store, and only the key is put in the button; they are rejected
+// with ErrCallbackDataTooLong if Store is nil. The key is derived from the action and
+// the payload, so stored payloads cannot be replayed under another action even without Key.
+type CallbackCodec struct {
+	Key       []byte        // secret key of HMAC, payloads are not signed if empty
+	SigLength int           // length of truncated signature in bytes, defaults to 8, raised to 4 and capped at 32
+	Store     CallbackStore // optional, stores large payloads server-side
+}
+
+func (c *CallbackCodec) sigLength() int {
+	if len(c.Key) == 0 {
+		return 0
+	}
+	switch {
+	case c.SigLength <= 0:
+		return 8
+	case c.SigLength < minSigLength:
+		return minSigLength
+	case c.SigLength > sha256.Size:
+		return sha256.Size
+	}
+	return c.SigLength
+}
+
+func (c *CallbackCodec) sign(action string, body []byte) []byte {
+	l := c.sigLength()
+	if l == 0 {
+		return nil
+	}
+
+	mac := hmac.New(sha256.New, c.Key)
+	mac.Write([]byte(action))
+	mac.Write([]byte{':'})
+	mac.Write(body)
+	return mac.Sum(nil)[:l]
+}
+
+func (c *CallbackCodec) pack(action string, body []byte) string {
+	body = append(body, c.sign(action, body)...)
+	return action + ":" + encodeRawBase64(body)
+}
+
+// Encode encodes v, which must be a struct, pointer to struct or nil, into callback data
+func (c *CallbackCodec) Encode(action string, v interface{}) (string, error) {
+	if strings.IndexByte(action, ':') >= 0 {
+		return "", fmt.Errorf("action %s contains colon", action)
+	}
+
+	payload := &bytes.Buffer{}
+	if v != nil {
+		if err := encodeCompact(payload, reflect.ValueOf(v)); err != nil {
+			return "", err
+		}
+	}
+
+	ret := c.pack(action, append([]byte{inlinePayload}, payload.Bytes()...))
+	if len(ret) <= MaxCallbackDataLength {
+		return ret, nil
+	}
+	if c.Store == nil {
+		return "", ErrCallbackDataTooLong
+	}
+
+	key := storeKey(action, payload.Bytes())
+	if err := c.Store.Store(hex.EncodeToString(key), payload.Bytes()); err != nil {
+		return "", err
+	}
+	ret = c.pack(action, append([]byte{storedPayload}, key...))
+	if len(ret) > MaxCallbackDataLength {
+		return "", ErrCallbackDataTooLong
+	}
+	return ret, nil
+}
+
+// storeKey binds stored payload to the action, so it cannot be replayed under another action
+func storeKey(action string, payload []byte) []byte {
+	sum := sha256.Sum256(append([]byte(action+":"), payload...))
+	return sum[:callbackStoreKeyLength]
+}
+
+// Decode verifies the data and decodes payload into v, which must be a pointer to struct or nil
+func (c *CallbackCodec) Decode(data string, v interface{}) (action string, err error) {
+	idx := strings.IndexByte(data, ':')
+	if idx < 0 {
+		return "", ErrMalformedCallbackData
+	}
+	action = data[:idx]
+	buf, err := decodeRawBase64(data[idx+1:])
+	if err != nil {
+		return "", ErrMalformedCallbackData
+	}
+
+	l := c.sigLength()
+	if len(buf) < 1+l {
+		return "", ErrMalformedCallbackData
+	}
+	body, sig := buf[:len(buf)-l], buf[len(buf)-l:]
+	if !hmac.Equal(sig, c.sign(action, body)) {
+		return "", ErrInvalidSignature
+	}
+
+	payload := body[1:]
+	switch body[0] {
+	case inlinePayload:
+	case storedPayload:
+		if c.Store == nil || len(payload) != callbackStoreKeyLength {
+			return "", ErrMalformedCallbackData
+		}
+		key := payload
+		if payload, err = c.Store.Load(hex.EncodeToString(key)); err != nil {
+			return "", err
+		}
+		if payload == nil {
+			return "", fmt.Errorf("callback payload of %s is not found in store", action)
+		}
+		if !hmac.Equal(key, storeKey(action, payload)) {
+			return "", ErrInvalidSignature
+		}
+	default:
+		return "", ErrMalformedCallbackData
+	}
+
+	if v == nil {
+		return action, nil
+	}
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return "", fmt.Errorf("cannot decode callback payload into %T", v)
+	}
+	r := bytes.NewReader(payload)
+	if err = decodeCompact(r, val.Elem()); err != nil {
+		return "", err
+	}
+	if r.Len() > 0 {
+		return "", ErrMalformedCallbackData
+	}
+
+	return action, nil
+}
+
+// encodeCompact writes v as varints and length-prefixed bytes, in field order
+func encodeCompact(w *bytes.Buffer, v reflect.Value) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return fmt.Errorf("cannot encode nil %s into callback payload", v.Type())
+		}
+		return encodeCompact(w, v.Elem())
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			if err := encodeCompact(w, v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Bool:
+		if v.Bool() {
+			w.WriteByte(1)
+		} else {
+			w.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		w.Write(buf[:binary.PutVarint(buf, v.Int())])
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		w.Write(buf[:binary.PutUvarint(buf, v.Uint())])
+	case reflect.Float32, reflect.Float64:
+		binary.LittleEndian.PutUint64(buf, math.Float64bits(v.Float()))
+		w.Write(buf[:8])
+	case reflect.String:
+		w.Write(buf[:binary.PutUvarint(buf, uint64(v.Len()))])
+		w.WriteString(v.String())
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("cannot encode %s into callback payload", v.Type())
+		}
+		w.Write(buf[:binary.PutUvarint(buf, uint64(v.Len()))])
+		w.Write(v.Bytes())
+	default:
+		return fmt.Errorf("cannot encode %s into callback payload", v.Type())
+	}
+
+	return nil
+}
+
+// decodeCompact reads data written by encodeCompact into v
+func decodeCompact(r *bytes.Reader, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeCompact(r, v.Elem())
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			if err := decodeCompact(r, v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Bool:
+		b, err := r.ReadByte()
+		if err != nil || b > 1 {
+			return ErrMalformedCallbackData
+		}
+		v.SetBool(b == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := binary.ReadVarint(r)
+		if err != nil || v.OverflowInt(i) {
+			return ErrMalformedCallbackData
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := binary.ReadUvarint(r)
+		if err != nil || v.OverflowUint(u) {
+			return ErrMalformedCallbackData
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		buf := make([]byte, 8)
+		if n, _ := r.Read(buf); n != 8 {
+			return ErrMalformedCallbackData
+		}
+		f := math.Float64frombits(binary.LittleEndian.Uint64(buf))
+		if v.OverflowFloat(f) {
+			return ErrMalformedCallbackData
+		}
+		v.SetFloat(f)
+	case reflect.String, reflect.Slice:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("cannot decode callback payload into %s", v.Type())
+		}
+		l, err := binary.ReadUvarint(r)
+		if err != nil || l > uint64(r.Len()) {
+			return ErrMalformedCallbackData
+		}
+		buf := make([]byte, l)
+		r.Read(buf)
+		if v.Kind() == reflect.String {
+			v.SetString(string(buf))
+		} else {
+			v.SetBytes(buf)
+		}
+	default:
+		return fmt.Errorf("cannot decode callback payload into %s", v.Type())
+	}
+
+	return nil
+}
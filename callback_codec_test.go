@@ -0,0 +1,128 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type orderPayload struct {
+	ID      int64
+	Page    uint8
+	Confirm bool
+	Note    string
+	hidden  string
+}
+
+func TestCallbackCodec(t *testing.T) {
+	tbl := []struct {
+		name  string
+		codec *CallbackCodec
+		data  orderPayload
+	}{
+		{"plain", &CallbackCodec{}, orderPayload{ID: -12345, Page: 3, Confirm: true, Note: "hi"}},
+		{"signed", &CallbackCodec{Key: []byte("secret")}, orderPayload{ID: 1 << 40, Note: "你好"}},
+		{"stored", &CallbackCodec{Key: []byte("secret"), Store: &MemoryCallbackStore{}}, orderPayload{ID: 1, Note: strings.Repeat("long", 30)}},
+	}
+
+	for _, data := range tbl {
+		encoded, err := data.codec.Encode("order", data.data)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", data.name, err)
+		}
+		if len(encoded) > MaxCallbackDataLength || !strings.HasPrefix(encoded, "order:") {
+			t.Errorf("%s: unexpected callback data %s", data.name, encoded)
+		}
+
+		var actual orderPayload
+		action, err := data.codec.Decode(encoded, &actual)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", data.name, err)
+		}
+		if action != "order" || !reflect.DeepEqual(actual, data.data) {
+			t.Errorf("%s: expected order %#v, got %s %#v", data.name, data.data, action, actual)
+		}
+	}
+}
+
+func TestCallbackCodecInvalid(t *testing.T) {
+	c := &CallbackCodec{Key: []byte("secret")}
+	encoded, _ := c.Encode("order", orderPayload{ID: 1})
+	forged, _ := (&CallbackCodec{Key: []byte("guess")}).Encode("order", orderPayload{ID: 2})
+	renamed := "cancel" + encoded[len("order"):]
+
+	tbl := []struct {
+		name   string
+		data   string
+		expect error
+	}{
+		{"forged", forged, ErrInvalidSignature},
+		{"renamed action", renamed, ErrInvalidSignature},
+		{"unsigned", "order:AAI", ErrMalformedCallbackData},
+		{"no action", "AAI", ErrMalformedCallbackData},
+		{"not base64", "order:!!", ErrMalformedCallbackData},
+	}
+
+	for _, data := range tbl {
+		var p orderPayload
+		if _, err := c.Decode(data.data, &p); err != data.expect {
+			t.Errorf("%s: expected %v, got %v", data.name, data.expect, err)
+		}
+	}
+
+	if _, err := c.Encode("order", orderPayload{Note: strings.Repeat("long", 30)}); err != ErrCallbackDataTooLong {
+		t.Errorf("expected ErrCallbackDataTooLong without store, got %v", err)
+	}
+
+	huge, _ := c.Encode("price", struct{ Price float64 }{1e300})
+	var price struct{ Price float32 }
+	if _, err := c.Decode(huge, &price); err != ErrMalformedCallbackData {
+		t.Errorf("expected ErrMalformedCallbackData for float32 overflow, got %v", err)
+	}
+}
+
+func TestCallbackCodecStored(t *testing.T) {
+	store := &MemoryCallbackStore{Max: 1}
+	c := &CallbackCodec{Store: store}
+	long := orderPayload{Note: strings.Repeat("long", 30)}
+
+	encoded, err := c.Encode("order", long)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var p orderPayload
+	if _, err = c.Decode("cancel"+encoded[len("order"):], &p); err != ErrInvalidSignature {
+		t.Errorf("expected ErrInvalidSignature for stored payload under another action, got %v", err)
+	}
+
+	if _, err = c.Encode("order", orderPayload{ID: 1, Note: long.Note}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err = c.Decode(encoded, &p); err == nil {
+		t.Errorf("expected evicted payload to fail decoding")
+	}
+}
+
+func TestCallbackCodecSigLength(t *testing.T) {
+	tbl := []struct {
+		key    string
+		length int
+		expect int
+	}{
+		{"", 16, 0},
+		{"secret", 0, 8},
+		{"secret", 1, 4},
+		{"secret", 16, 16},
+		{"secret", 64, 32},
+	}
+
+	for _, data := range tbl {
+		c := &CallbackCodec{Key: []byte(data.key), SigLength: data.length}
+		if actual := c.sigLength(); actual != data.expect {
+			t.Errorf("SigLength %d with key %q: expected %d, got %d", data.length, data.key, data.expect, actual)
+		}
+	}
+}
@@ -4,55 +4,163 @@
 package telegram
 
 import (
+	"encoding/json"
+	"io"
 	"net/url"
 	"strconv"
 )
 
 // KickChatMember maps to https://core.telegram.org/bots/api#kickchatmember
-func (a *api) KickChatMember(chat string, user int) error {
+//
+// Deprecated: Telegram renamed this method, use BanChatMember instead.
+func (a *api) KickChatMember(chat ChatID, user int64) error {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
-	params.Set("user_id", strconv.Itoa(user))
+	params.Set("chat_id", string(chat))
+	params.Set("user_id", strconv.FormatInt(user, 10))
 
 	return a.callAndSet("kickChatMember", params, nil)
 }
 
+// BanChatMember maps to https://core.telegram.org/bots/api#banchatmember
+//
+// Pass 0 as until to ban forever. Set revoke to delete all messages from the user.
+func (a *api) BanChatMember(chat ChatID, user, until int64, revoke bool) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("user_id", strconv.FormatInt(user, 10))
+	optInt64(params, "until_date", until)
+	optBool(params, "revoke_messages", revoke)
+
+	return a.callAndSet("banChatMember", params, nil)
+}
+
+// RestrictChatMember maps to https://core.telegram.org/bots/api#restrictchatmember
+//
+// Pass 0 as until to restrict forever.
+func (a *api) RestrictChatMember(chat ChatID, user int64, perms ChatPermissions, until int64) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("user_id", strconv.FormatInt(user, 10))
+	optInt64(params, "until_date", until)
+
+	buf, err := json.Marshal(perms)
+	if err != nil {
+		return err
+	}
+	params.Set("permissions", string(buf))
+
+	return a.callAndSet("restrictChatMember", params, nil)
+}
+
+// PromoteChatMember maps to https://core.telegram.org/bots/api#promotechatmember
+//
+// Pass zero-valued rights to demote the user.
+func (a *api) PromoteChatMember(chat ChatID, user int64, rights ChatAdministratorRights) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("user_id", strconv.FormatInt(user, 10))
+
+	optBool(params, "is_anonymous", rights.Anonymous)
+	optBool(params, "can_manage_chat", rights.CanManageChat)
+	optBool(params, "can_delete_messages", rights.CanDeleteMessages)
+	optBool(params, "can_manage_video_chats", rights.CanManageVideoChats)
+	optBool(params, "can_restrict_members", rights.CanRestrictMembers)
+	optBool(params, "can_promote_members", rights.CanPromoteMembers)
+	optBool(params, "can_change_info", rights.CanChangeInfo)
+	optBool(params, "can_invite_users", rights.CanInviteUsers)
+	optBool(params, "can_post_messages", rights.CanPostMessages)
+	optBool(params, "can_edit_messages", rights.CanEditMessages)
+	optBool(params, "can_pin_messages", rights.CanPinMessages)
+	optBool(params, "can_manage_topics", rights.CanManageTopics)
+
+	return a.callAndSet("promoteChatMember", params, nil)
+}
+
+// SetChatAdministratorCustomTitle maps to https://core.telegram.org/bots/api#setchatadministratorcustomtitle
+func (a *api) SetChatAdministratorCustomTitle(chat ChatID, user int64, title string) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("user_id", strconv.FormatInt(user, 10))
+	params.Set("custom_title", title)
+
+	return a.callAndSet("setChatAdministratorCustomTitle", params, nil)
+}
+
+// SetChatPermissions sets default permissions for all members, maps to https://core.telegram.org/bots/api#setchatpermissions
+func (a *api) SetChatPermissions(chat ChatID, perms ChatPermissions) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+
+	buf, err := json.Marshal(perms)
+	if err != nil {
+		return err
+	}
+	params.Set("permissions", string(buf))
+
+	return a.callAndSet("setChatPermissions", params, nil)
+}
+
+// BanChatSenderChat maps to https://core.telegram.org/bots/api#banchatsenderchat
+func (a *api) BanChatSenderChat(chat ChatID, sender int64) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("sender_chat_id", strconv.FormatInt(sender, 10))
+
+	return a.callAndSet("banChatSenderChat", params, nil)
+}
+
+// UnbanChatSenderChat maps to https://core.telegram.org/bots/api#unbanchatsenderchat
+func (a *api) UnbanChatSenderChat(chat ChatID, sender int64) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("sender_chat_id", strconv.FormatInt(sender, 10))
+
+	return a.callAndSet("unbanChatSenderChat", params, nil)
+}
+
 // LeaveChat maps to https://core.telegram.org/bots/api#leavechat
-func (a *api) LeaveChat(chat string) error {
+func (a *api) LeaveChat(chat ChatID) error {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 
 	return a.callAndSet("leaveChat", params, nil)
 }
 
 // UnbanChatMember maps to https://core.telegram.org/bots/api#unbanchatmember
-func (a *api) UnbanChatMember(chat string, user int) error {
+func (a *api) UnbanChatMember(chat ChatID, user int64) error {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
-	params.Set("user_id", strconv.Itoa(user))
+	params.Set("chat_id", string(chat))
+	params.Set("user_id", strconv.FormatInt(user, 10))
 
 	return a.callAndSet("unbanChatMember", params, nil)
 }
 
 // GetChat maps to https://core.telegram.org/bots/api#getchat
-func (a *api) GetChat(chat string) (*Victim, error) {
+func (a *api) GetChat(chat ChatID) (*ChatFullInfo, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 
-	var r userResult
+	var r chatResult
 	err := a.callAndSet("getChat", params, &r)
-	return r.User, err
+	return r.Chat, err
 }
 
 // GetChatAdministrators maps to https://core.telegram.org/bots/api#getchatadministrators
-func (a *api) GetChatAdministrators(chat string) ([]ChatMember, error) {
+func (a *api) GetChatAdministrators(chat ChatID) ([]ChatMember, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 
 	var r membersResult
 	err := a.callAndSet("getChatAdministrators", params, &r)
@@ -60,10 +168,10 @@ func (a *api) GetChatAdministrators(chat string) ([]ChatMember, error) {
 }
 
 // GetChatMembersCount maps to https://core.telegram.org/bots/api#getchatmemberscount
-func (a *api) GetChatMembersCount(chat string) (int, error) {
+func (a *api) GetChatMembersCount(chat ChatID) (int, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 
 	var r intResult
 	err := a.callAndSet("getChatMembersCount", params, &r)
@@ -71,12 +179,138 @@ func (a *api) GetChatMembersCount(chat string) (int, error) {
 }
 
 // GetChatMember maps to https://core.telegram.org/bots/api#getchatmember
-func (a *api) GetChatMember(chat string) (*ChatMember, error) {
+func (a *api) GetChatMember(chat ChatID, user int64) (*ChatMember, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
+	params.Set("user_id", strconv.FormatInt(user, 10))
 
 	var r memberResult
 	err := a.callAndSet("getChatMember", params, &r)
 	return r.Member, err
 }
+
+// SetChatTitle maps to https://core.telegram.org/bots/api#setchattitle
+func (a *api) SetChatTitle(chat ChatID, title string) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("title", title)
+
+	return a.callAndSet("setChatTitle", params, nil)
+}
+
+// SetChatDescription maps to https://core.telegram.org/bots/api#setchatdescription
+func (a *api) SetChatDescription(chat ChatID, desc string) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	optStr(params, "description", desc)
+
+	return a.callAndSet("setChatDescription", params, nil)
+}
+
+// SetChatPhoto uploads a new chat photo, maps to https://core.telegram.org/bots/api#setchatphoto
+func (a *api) SetChatPhoto(chat ChatID, photo io.Reader) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+
+	return a.uploadAndSet("setChatPhoto", params, "photo", photo, nil)
+}
+
+// DeleteChatPhoto maps to https://core.telegram.org/bots/api#deletechatphoto
+func (a *api) DeleteChatPhoto(chat ChatID) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+
+	return a.callAndSet("deleteChatPhoto", params, nil)
+}
+
+// ExportChatInviteLink generates new primary invite link, maps to https://core.telegram.org/bots/api#exportchatinvitelink
+func (a *api) ExportChatInviteLink(chat ChatID) (string, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+
+	var r strResult
+	err := a.callAndSet("exportChatInviteLink", params, &r)
+	return r.Result, err
+}
+
+// InviteLinkOptions represents optional parameters for api method createChatInviteLink and editChatInviteLink
+type InviteLinkOptions struct {
+	Name        string
+	ExpireDate  int64 // unix timestamp
+	MemberLimit int   // 1-99999, cannot be used together with JoinRequest
+	JoinRequest bool  // users joining via the link need to be approved
+}
+
+func (o *InviteLinkOptions) set(params url.Values) {
+	if o == nil {
+		return
+	}
+
+	optStr(params, "name", o.Name)
+	optInt64(params, "expire_date", o.ExpireDate)
+	optInt(params, "member_limit", o.MemberLimit)
+	optBool(params, "creates_join_request", o.JoinRequest)
+}
+
+// CreateChatInviteLink maps to https://core.telegram.org/bots/api#createchatinvitelink
+func (a *api) CreateChatInviteLink(chat ChatID, opts *InviteLinkOptions) (*ChatInviteLink, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	opts.set(params)
+
+	var r inviteLinkResult
+	err := a.callAndSet("createChatInviteLink", params, &r)
+	return r.Link, err
+}
+
+// EditChatInviteLink maps to https://core.telegram.org/bots/api#editchatinvitelink
+func (a *api) EditChatInviteLink(chat ChatID, link string, opts *InviteLinkOptions) (*ChatInviteLink, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("invite_link", link)
+	opts.set(params)
+
+	var r inviteLinkResult
+	err := a.callAndSet("editChatInviteLink", params, &r)
+	return r.Link, err
+}
+
+// RevokeChatInviteLink maps to https://core.telegram.org/bots/api#revokechatinvitelink
+func (a *api) RevokeChatInviteLink(chat ChatID, link string) (*ChatInviteLink, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("invite_link", link)
+
+	var r inviteLinkResult
+	err := a.callAndSet("revokeChatInviteLink", params, &r)
+	return r.Link, err
+}
+
+// ApproveChatJoinRequest maps to https://core.telegram.org/bots/api#approvechatjoinrequest
+func (a *api) ApproveChatJoinRequest(chat ChatID, user int64) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("user_id", strconv.FormatInt(user, 10))
+
+	return a.callAndSet("approveChatJoinRequest", params, nil)
+}
+
+// DeclineChatJoinRequest maps to https://core.telegram.org/bots/api#declinechatjoinrequest
+func (a *api) DeclineChatJoinRequest(chat ChatID, user int64) error {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("user_id", strconv.FormatInt(user, 10))
+
+	return a.callAndSet("declineChatJoinRequest", params, nil)
+}
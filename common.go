@@ -4,10 +4,13 @@
 package telegram
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 // types for api method result
@@ -29,6 +32,11 @@ type intResult struct {
 	Result int64 `json:"result"`
 }
 
+type strResult struct {
+	boolResult
+	Result string `json:"result"`
+}
+
 type updateResult struct {
 	boolResult
 	Updates []Update `json:"result"`
@@ -36,7 +44,12 @@ type updateResult struct {
 
 type userResult struct {
 	boolResult
-	User *Victim `json:"result"`
+	User *User `json:"result"`
+}
+
+type chatResult struct {
+	boolResult
+	Chat *ChatFullInfo `json:"result"`
 }
 
 type msgResult struct {
@@ -44,16 +57,55 @@ type msgResult struct {
 	Message *Message `json:"result"`
 }
 
+type pollResult struct {
+	boolResult
+	Poll *Poll `json:"result"`
+}
+
 type profilePhotoResult struct {
 	boolResult
 	UserProfilePhotos *UserProfilePhotos `json:"result"`
 }
 
+type botInfoResult struct {
+	boolResult
+	Info struct {
+		Name             string `json:"name"`
+		Description      string `json:"description"`
+		ShortDescription string `json:"short_description"`
+	} `json:"result"`
+}
+
+type menuButtonResult struct {
+	boolResult
+	Button *MenuButton `json:"result"`
+}
+
+type rightsResult struct {
+	boolResult
+	Rights *ChatAdministratorRights `json:"result"`
+}
+
+type highScoresResult struct {
+	boolResult
+	Scores []GameHighScore `json:"result"`
+}
+
+type commandsResult struct {
+	boolResult
+	Commands []BotCommand `json:"result"`
+}
+
 type fileResult struct {
 	boolResult
 	File *File `json:"result"`
 }
 
+type inviteLinkResult struct {
+	boolResult
+	Link *ChatInviteLink `json:"result"`
+}
+
 type memberResult struct {
 	boolResult
 	Member *ChatMember `json:"result"`
@@ -88,6 +140,23 @@ func (e ErrNotOK) Error() string {
 	)
 }
 
+// ResponseParameters describes why a request was unsuccessful.
+type ResponseParameters struct {
+	MigrateTo  int64 `json:"migrate_to_chat_id,omitempty"`
+	RetryAfter int   `json:"retry_after,omitempty"`
+}
+
+// Parameters parses response parameters returned by Telegram server, nil if not present
+func (e ErrNotOK) Parameters() *ResponseParameters {
+	var r struct {
+		Parameters *ResponseParameters `json:"parameters"`
+	}
+	if err := json.Unmarshal(e.Bytes, &r); err != nil {
+		return nil
+	}
+	return r.Parameters
+}
+
 // helpers
 
 func optStr(params url.Values, key, val string) {
@@ -102,6 +171,12 @@ func optInt(params url.Values, key string, val int) {
 	}
 }
 
+func optInt64(params url.Values, key string, val int64) {
+	if val != 0 {
+		params.Set(key, strconv.FormatInt(val, 10))
+	}
+}
+
 func optBool(params url.Values, key string, val bool) {
 	if val {
 		params.Set(key, "true")
@@ -120,6 +195,22 @@ func optJSON(params url.Values, key string, val []byte) {
 	}
 }
 
+// encodeRawBase64 encodes buf in unpadded base64url, base64.RawURLEncoding needs Go 1.5
+func encodeRawBase64(buf []byte) string {
+	return strings.TrimRight(base64.URLEncoding.EncodeToString(buf), "=")
+}
+
+// decodeRawBase64 decodes unpadded base64url, padded input is rejected
+func decodeRawBase64(s string) ([]byte, error) {
+	if idx := strings.IndexByte(s, '='); idx >= 0 {
+		return nil, base64.CorruptInputError(idx)
+	}
+	if l := len(s) % 4; l > 0 {
+		s += strings.Repeat("=", 4-l)
+	}
+	return base64.URLEncoding.DecodeString(s)
+}
+
 // Options abstracts some commonly used options for api methods
 //
 // Not every option supported by every api method.
@@ -127,6 +218,6 @@ type Options struct {
 	ParseMode   string
 	NoPreview   bool
 	Silent      bool
-	ReplyID     int
+	ReplyID     int64
 	ReplyMarkup ReplyMarkup
 }
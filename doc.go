@@ -4,6 +4,7 @@
 /*
 Package telegram represents Telegram Bot API.
 
-API methods listed here DO NOT VALIDATE the content of parameters.
+API methods listed here DO NOT VALIDATE the content of parameters,
+except AnswerInlineQuery, which validates results by ValidateResults.
 */
 package telegram
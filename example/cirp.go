@@ -8,7 +8,7 @@ import (
 
 type chosenInlineResultProcessor struct {
 	API telegram.API
-	ME  *telegram.Victim
+	ME  *telegram.User
 	CH  chan *telegram.ChosenInlineResult
 }
 
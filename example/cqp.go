@@ -9,7 +9,7 @@ import (
 
 type callbackQueryProcessor struct {
 	API telegram.API
-	ME  *telegram.Victim
+	ME  *telegram.User
 	CH  chan *telegram.CallbackQuery
 }
 
@@ -17,7 +17,7 @@ func (p *callbackQueryProcessor) Run() {
 	for c := range p.CH {
 		msg := fmt.Sprintf("Received callback query:\n```\n%s\n```", toString(c))
 		if _, err := p.API.EditText(
-			c.Message.Chat.Identifier(),
+			c.Message.Chat.ChatID(),
 			c.Message.ID,
 			msg,
 			telegram.MarkdownMode,
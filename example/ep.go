@@ -9,16 +9,16 @@ import (
 
 type editedProcessor struct {
 	API telegram.API
-	ME  *telegram.Victim
+	ME  *telegram.User
 	CH  chan *telegram.Message
 }
 
 func (p *editedProcessor) Run() {
 	for m := range p.CH {
-		msg := fmt.Sprintf("Received edited message:\n```\n%#s\n```", toString(m))
-		if _, err := p.API.SendMessage(m.From.Identifier(), msg, &telegram.Options{
+		msg := fmt.Sprintf("Received edited message:\n```\n%s\n```", toString(m))
+		if _, err := p.API.SendMessage(m.From.ChatID(), msg, &telegram.Options{
 			ReplyMarkup: &telegram.InlineKeyboardMarkup{
-				[][]telegram.InlineKeyboardButton{
+				Keyboard: [][]telegram.InlineKeyboardButton{
 					[]telegram.InlineKeyboardButton{
 						telegram.InlineKeyboardButton{
 							Text: "1",
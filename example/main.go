@@ -33,12 +33,12 @@ func main() {
 	go cqp.Run()
 
 	fetcher := &telegram.LongPollFetcher{
-		mp.CH,
-		ep.CH,
-		iqp.CH,
-		cirp.CH,
-		cqp.CH,
-		api,
+		Message:            mp.CH,
+		EditedMessage:      ep.CH,
+		InlineQuery:        iqp.CH,
+		ChosenInlineResult: cirp.CH,
+		CallbackQuery:      cqp.CH,
+		API:                api,
 	}
 
 	log.Fatal(fetcher.Fetch(10, 30))
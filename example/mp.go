@@ -9,14 +9,14 @@ import (
 
 type messageProcessor struct {
 	API telegram.API
-	ME  *telegram.Victim
+	ME  *telegram.User
 	CH  chan *telegram.Message
 }
 
 func (p *messageProcessor) Run() {
 	for m := range p.CH {
-		msg := fmt.Sprintf("Received message:\n```\n%#s\n```\nEntities: %v", toString(m), m.EntityText())
-		if _, err := p.API.SendMessage(m.From.Identifier(), msg, &telegram.Options{
+		msg := fmt.Sprintf("Received message:\n```\n%s\n```\nEntities: %v", toString(m), m.EntityText())
+		if _, err := p.API.SendMessage(m.From.ChatID(), msg, &telegram.Options{
 			ReplyMarkup: &telegram.ReplyKeyboardMarkup{
 				Keyboard: [][]telegram.KeyboardButton{
 					[]telegram.KeyboardButton{
@@ -8,15 +8,15 @@ import "io"
 // Fake is mocked telegram API, provides dumb response for you.
 //
 // You should not use this directly, embbed it and overwrite the methods you need instead.
-func Fake(me *Victim) API {
+func Fake(me *User) API {
 	return &fake{me}
 }
 
 type fake struct {
-	Me *Victim
+	Me *User
 }
 
-func (f *fake) AnswerCallbackQuery(query, text string, alert bool) error {
+func (f *fake) AnswerCallbackQuery(query, text string, alert bool, opts *CallbackQueryOptions) error {
 	return nil
 }
 
@@ -24,7 +24,51 @@ func (f *fake) AnswerInlineQuery(query string, results []InlineQueryResult, opts
 	return nil
 }
 
-func (f *fake) EditCaption(chat string, msg int, caption, mode string, noPreview bool, markup ReplyMarkup) (*Message, error) {
+func (f *fake) AnswerPreCheckoutQuery(query string, ok bool, errMsg string) error {
+	return nil
+}
+
+func (f *fake) AnswerShippingQuery(query string, ok bool, options []ShippingOption, errMsg string) error {
+	return nil
+}
+
+func (f *fake) ApproveChatJoinRequest(chat ChatID, user int64) error {
+	return nil
+}
+
+func (f *fake) BanChatMember(chat ChatID, user, until int64, revoke bool) error {
+	return nil
+}
+
+func (f *fake) BanChatSenderChat(chat ChatID, sender int64) error {
+	return nil
+}
+
+func (f *fake) CreateChatInviteLink(chat ChatID, opts *InviteLinkOptions) (*ChatInviteLink, error) {
+	return nil, nil
+}
+
+func (f *fake) CreateInvoiceLink(title, desc, payload, provider, currency string, prices []LabeledPrice, invOpts *InvoiceOptions) (string, error) {
+	return "", nil
+}
+
+func (f *fake) DeclineChatJoinRequest(chat ChatID, user int64) error {
+	return nil
+}
+
+func (f *fake) DeleteChatPhoto(chat ChatID) error {
+	return nil
+}
+
+func (f *fake) DeleteMyCommands(scope *BotCommandScope, lang string) error {
+	return nil
+}
+
+func (f *fake) EditCaption(chat ChatID, msg int64, caption, mode string, noPreview bool, markup ReplyMarkup) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) EditChatInviteLink(chat ChatID, link string, opts *InviteLinkOptions) (*ChatInviteLink, error) {
 	return nil, nil
 }
 
@@ -32,6 +76,10 @@ func (f *fake) EditInlineCaption(msg, caption, mode string, noPreview bool, mark
 	return nil, nil
 }
 
+func (f *fake) EditInlineLiveLocation(msg string, lat, lng float64, markup ReplyMarkup) (*Message, error) {
+	return nil, nil
+}
+
 func (f *fake) EditInlineMarkup(msg string, markup ReplyMarkup) (*Message, error) {
 	return nil, nil
 }
@@ -40,130 +88,286 @@ func (f *fake) EditInlineText(msg, text, mode string, noPreview bool, markup Rep
 	return nil, nil
 }
 
-func (f *fake) EditMarkup(chat string, msg int, markup ReplyMarkup) (*Message, error) {
+func (f *fake) EditLiveLocation(chat ChatID, msg int64, lat, lng float64, markup ReplyMarkup) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) EditText(chat string, msg int, text, mode string, noPreview bool, markup ReplyMarkup) (*Message, error) {
+func (f *fake) EditMarkup(chat ChatID, msg int64, markup ReplyMarkup) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) ForwardMessage(to, from string, silent bool, message int) (*Message, error) {
+func (f *fake) EditText(chat ChatID, msg int64, text, mode string, noPreview bool, markup ReplyMarkup) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) GetChat(chat string) (*Victim, error) {
+func (f *fake) ExportChatInviteLink(chat ChatID) (string, error) {
+	return "", nil
+}
+
+func (f *fake) ForwardMessage(to, from ChatID, silent bool, message int64) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) GetChatAdministrators(chat string) ([]ChatMember, error) {
+func (f *fake) GetChat(chat ChatID) (*ChatFullInfo, error) {
+	return nil, nil
+}
+
+func (f *fake) GetChatAdministrators(chat ChatID) ([]ChatMember, error) {
 	return []ChatMember{}, nil
 }
 
-func (f *fake) GetChatMember(chat string) (*ChatMember, error) {
+func (f *fake) GetChatMember(chat ChatID, user int64) (*ChatMember, error) {
 	return nil, nil
 }
 
-func (f *fake) GetChatMembersCount(chat string) (int, error) {
+func (f *fake) GetChatMembersCount(chat ChatID) (int, error) {
 	return 0, nil
 }
 
+func (f *fake) GetChatMenuButton(chat ChatID) (*MenuButton, error) {
+	return nil, nil
+}
+
 func (f *fake) GetFile(file string) (*File, error) {
 	return nil, nil
 }
 
-func (f *fake) GetMe() (*Victim, error) {
+func (f *fake) GetGameHighScores(chat ChatID, msg, user int64) ([]GameHighScore, error) {
+	return []GameHighScore{}, nil
+}
+
+func (f *fake) GetInlineGameHighScores(msg string, user int64) ([]GameHighScore, error) {
+	return []GameHighScore{}, nil
+}
+
+func (f *fake) GetMe() (*User, error) {
 	return f.Me, nil
 }
 
-func (f *fake) GetUpdates(offset, limit, timeout int) ([]Update, error) {
+func (f *fake) GetMyCommands(scope *BotCommandScope, lang string) ([]BotCommand, error) {
+	return []BotCommand{}, nil
+}
+
+func (f *fake) GetMyDefaultAdministratorRights(channels bool) (*ChatAdministratorRights, error) {
+	return nil, nil
+}
+
+func (f *fake) GetMyDescription(lang string) (string, error) {
+	return "", nil
+}
+
+func (f *fake) GetMyName(lang string) (string, error) {
+	return "", nil
+}
+
+func (f *fake) GetMyShortDescription(lang string) (string, error) {
+	return "", nil
+}
+
+func (f *fake) GetUpdates(offset int64, limit, timeout int, allowed []string) ([]Update, error) {
 	return []Update{}, nil
 }
 
-func (f *fake) GetUserProfilePhotos(user, offset, limit int) (*UserProfilePhotos, error) {
+func (f *fake) GetUserProfilePhotos(user int64, offset, limit int) (*UserProfilePhotos, error) {
 	return nil, nil
 }
 
-func (f *fake) KickChatMember(chat string, user int) error {
+func (f *fake) KickChatMember(chat ChatID, user int64) error {
+	return nil
+}
+
+func (f *fake) LeaveChat(chat ChatID) error {
+	return nil
+}
+
+func (f *fake) PromoteChatMember(chat ChatID, user int64, rights ChatAdministratorRights) error {
 	return nil
 }
 
-func (f *fake) LeaveChat(chat string) error {
+func (f *fake) RestrictChatMember(chat ChatID, user int64, perms ChatPermissions, until int64) error {
 	return nil
 }
 
-func (f *fake) SendAudio(chat, audio string, duration int, performer, title string, opts *Options) (*Message, error) {
+func (f *fake) RevokeChatInviteLink(chat ChatID, link string) (*ChatInviteLink, error) {
 	return nil, nil
 }
 
-func (f *fake) SendChatAction(chat, action string) error {
+func (f *fake) SendAnimation(chat ChatID, animation string, duration, width, height int, caption string, opts *Options) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) SendAudio(chat ChatID, audio string, duration int, performer, title string, opts *Options) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) SendChatAction(chat ChatID, action string) error {
 	return nil
 }
 
-func (f *fake) SendContact(chat, phone, firstName, lastName string, opts *Options) (*Message, error) {
+func (f *fake) SendContact(chat ChatID, phone, firstName, lastName string, opts *Options) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) SendDice(chat ChatID, emoji string, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) SendDocument(chat, document, caption string, opts *Options) (*Message, error) {
+func (f *fake) SendDocument(chat ChatID, document, caption string, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) SendLocation(chat string, lat, lng float64, opts *Options) (*Message, error) {
+func (f *fake) SendGame(chat ChatID, game string, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) SendMessage(chat, text string, opts *Options) (*Message, error) {
+func (f *fake) SendInvoice(chat ChatID, title, desc, payload, provider, currency string, prices []LabeledPrice, invOpts *InvoiceOptions, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) SendPhoto(chat, photo, caption string, opts *Options) (*Message, error) {
+func (f *fake) SendLiveLocation(chat ChatID, lat, lng float64, livePeriod int, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) SendSticker(chat, sticker, caption string, opts *Options) (*Message, error) {
+func (f *fake) SendLocation(chat ChatID, lat, lng float64, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) SendVenue(chat string, lat, lng float64, title, addr, foursq string, opts *Options) (*Message, error) {
+func (f *fake) SendMessage(chat ChatID, text string, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) SendVideo(chat, video string, duration, width, height int, caption string, opts *Options) (*Message, error) {
+func (f *fake) SendPhoto(chat ChatID, photo, caption string, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) SendVoice(chat, voice string, duration int, opts *Options) (*Message, error) {
+func (f *fake) SendPoll(chat ChatID, question string, answers []string, pollOpts *PollOptions, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
+func (f *fake) SendSticker(chat ChatID, sticker, caption string, opts *Options) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) SendVenue(chat ChatID, lat, lng float64, title, addr, foursq string, opts *Options) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) SendVideo(chat ChatID, video string, duration, width, height int, caption string, opts *Options) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) SendVideoNote(chat ChatID, videoNote string, duration, length int, opts *Options) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) SendVoice(chat ChatID, voice string, duration int, opts *Options) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) SetChatAdministratorCustomTitle(chat ChatID, user int64, title string) error {
+	return nil
+}
+
+func (f *fake) SetChatDescription(chat ChatID, desc string) error {
+	return nil
+}
+
+func (f *fake) SetChatMenuButton(chat ChatID, button *MenuButton) error {
+	return nil
+}
+
+func (f *fake) SetChatPermissions(chat ChatID, perms ChatPermissions) error {
+	return nil
+}
+
+func (f *fake) SetChatPhoto(chat ChatID, photo io.Reader) error {
+	return nil
+}
+
+func (f *fake) SetChatTitle(chat ChatID, title string) error {
+	return nil
+}
+
+func (f *fake) SetGameScore(chat ChatID, msg, user int64, score int, force, noEdit bool) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) SetInlineGameScore(msg string, user int64, score int, force, noEdit bool) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) SetMyCommands(commands []BotCommand, scope *BotCommandScope, lang string) error {
+	return nil
+}
+
+func (f *fake) SetMyDefaultAdministratorRights(rights *ChatAdministratorRights, channels bool) error {
+	return nil
+}
+
+func (f *fake) SetMyDescription(desc, lang string) error {
+	return nil
+}
+
+func (f *fake) SetMyName(name, lang string) error {
+	return nil
+}
+
+func (f *fake) SetMyShortDescription(desc, lang string) error {
+	return nil
+}
+
 func (f *fake) SetWebhook(cb string, certificate io.Reader) error {
 	return nil
 }
 
-func (f *fake) UnbanChatMember(chat string, user int) error {
+func (f *fake) StopInlineLiveLocation(msg string, markup ReplyMarkup) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) StopLiveLocation(chat ChatID, msg int64, markup ReplyMarkup) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) StopPoll(chat ChatID, msg int64, markup ReplyMarkup) (*Poll, error) {
+	return nil, nil
+}
+
+func (f *fake) UnbanChatMember(chat ChatID, user int64) error {
 	return nil
 }
 
-func (f *fake) UploadAudio(chat string, audio io.Reader, duration int, performer, title string, opts *Options) (*Message, error) {
+func (f *fake) UnbanChatSenderChat(chat ChatID, sender int64) error {
+	return nil
+}
+
+func (f *fake) UploadAnimation(chat ChatID, animation io.Reader, duration, width, height int, caption string, opts *Options) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) UploadAudio(chat ChatID, audio io.Reader, duration int, performer, title string, opts *Options) (*Message, error) {
+	return nil, nil
+}
+
+func (f *fake) UploadDocument(chat ChatID, document io.Reader, caption string, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) UploadDocument(chat string, document io.Reader, caption string, opts *Options) (*Message, error) {
+func (f *fake) UploadPhoto(chat ChatID, photo io.Reader, caption string, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) UploadPhoto(chat string, photo io.Reader, caption string, opts *Options) (*Message, error) {
+func (f *fake) UploadSticker(chat ChatID, sticker io.Reader, caption string, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) UploadSticker(chat string, sticker io.Reader, caption string, opts *Options) (*Message, error) {
+func (f *fake) UploadVideo(chat ChatID, video io.Reader, duration, width, height int, caption string, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) UploadVideo(chat string, video io.Reader, duration, width, height int, caption string, opts *Options) (*Message, error) {
+func (f *fake) UploadVideoNote(chat ChatID, videoNote io.Reader, duration, length int, opts *Options) (*Message, error) {
 	return nil, nil
 }
 
-func (f *fake) UploadVoice(chat string, voice io.Reader, duration int, opts *Options) (*Message, error) {
+func (f *fake) UploadVoice(chat ChatID, voice io.Reader, duration int, opts *Options) (*Message, error) {
 	return nil, nil
 }
@@ -0,0 +1,138 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"sync"
+)
+
+// FileIDStore persists file_id of uploaded contents, see NewFileCache
+//
+// Load returns empty string if key is not found.
+type FileIDStore interface {
+	Load(key string) (fileID string, err error)
+	Store(key, fileID string) error
+}
+
+// MemoryFileIDStore is a FileIDStore keeps everything in memory
+type MemoryFileIDStore struct {
+	lock sync.RWMutex
+	ids  map[string]string
+}
+
+// Load implements FileIDStore
+func (s *MemoryFileIDStore) Load(key string) (string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.ids[key], nil
+}
+
+// Store implements FileIDStore
+func (s *MemoryFileIDStore) Store(key, fileID string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.ids == nil {
+		s.ids = map[string]string{}
+	}
+	s.ids[key] = fileID
+	return nil
+}
+
+// NewFileCache wraps an API, remembering file_id of photos and documents uploaded through it.
+//
+// Later UploadPhoto/UploadDocument with identical content are turned into SendPhoto/SendDocument,
+// so the content is uploaded only once. Contents are identified by their SHA-256 hash.
+//
+// The whole content is read into memory before uploading. Pass nil store to use MemoryFileIDStore.
+func NewFileCache(a API, store FileIDStore) API {
+	if store == nil {
+		store = &MemoryFileIDStore{}
+	}
+	return &fileCache{a, store}
+}
+
+type fileCache struct {
+	API
+	Store FileIDStore
+}
+
+// read reads data into memory and computes the cache key
+func (c *fileCache) read(kind string, data io.Reader) (key string, buf []byte, err error) {
+	if buf, err = ioutil.ReadAll(data); err != nil {
+		return
+	}
+
+	sum := sha256.Sum256(buf)
+	return kind + ":" + hex.EncodeToString(sum[:]), buf, nil
+}
+
+// largestPhoto returns file_id of largest photo size
+func largestPhoto(photos []PhotoSize) (ret string) {
+	max := -1
+	for _, p := range photos {
+		if size := p.Width * p.Height; size > max {
+			max = size
+			ret = p.FileID
+		}
+	}
+
+	return
+}
+
+// UploadPhoto uploads the photo only if it is not uploaded before
+func (c *fileCache) UploadPhoto(chat ChatID, photo io.Reader, caption string, opts *Options) (*Message, error) {
+	key, buf, err := c.read("photo", photo)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.Store.Load(key)
+	if err != nil {
+		return nil, err
+	}
+	if id != "" {
+		return c.API.SendPhoto(chat, id, caption, opts)
+	}
+
+	msg, err := c.API.UploadPhoto(chat, bytes.NewReader(buf), caption, opts)
+	if err != nil || msg == nil {
+		return msg, err
+	}
+
+	if id := largestPhoto(msg.Photo); id != "" {
+		err = c.Store.Store(key, id)
+	}
+	return msg, err
+}
+
+// UploadDocument uploads the document only if it is not uploaded before
+func (c *fileCache) UploadDocument(chat ChatID, document io.Reader, caption string, opts *Options) (*Message, error) {
+	key, buf, err := c.read("document", document)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.Store.Load(key)
+	if err != nil {
+		return nil, err
+	}
+	if id != "" {
+		return c.API.SendDocument(chat, id, caption, opts)
+	}
+
+	msg, err := c.API.UploadDocument(chat, bytes.NewReader(buf), caption, opts)
+	if err != nil || msg == nil {
+		return msg, err
+	}
+
+	if msg.Document != nil && msg.Document.FileID != "" {
+		err = c.Store.Store(key, msg.Document.FileID)
+	}
+	return msg, err
+}
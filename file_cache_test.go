@@ -0,0 +1,61 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+type uploadCounter struct {
+	API
+	uploads int
+	sent    []string
+}
+
+func (u *uploadCounter) UploadPhoto(chat ChatID, photo io.Reader, caption string, opts *Options) (*Message, error) {
+	u.uploads++
+	ioutil.ReadAll(photo)
+	return &Message{Photo: []PhotoSize{
+		PhotoSize{FileID: "small", Width: 90, Height: 90},
+		PhotoSize{FileID: "large", Width: 800, Height: 600},
+		PhotoSize{FileID: "medium", Width: 320, Height: 240},
+	}}, nil
+}
+
+func (u *uploadCounter) SendPhoto(chat ChatID, photo, caption string, opts *Options) (*Message, error) {
+	u.sent = append(u.sent, photo)
+	return &Message{}, nil
+}
+
+func (u *uploadCounter) UploadDocument(chat ChatID, document io.Reader, caption string, opts *Options) (*Message, error) {
+	u.uploads++
+	ioutil.ReadAll(document)
+	return &Message{Document: &Document{FileID: "doc"}}, nil
+}
+
+func (u *uploadCounter) SendDocument(chat ChatID, document, caption string, opts *Options) (*Message, error) {
+	u.sent = append(u.sent, document)
+	return &Message{}, nil
+}
+
+func TestFileCache(t *testing.T) {
+	u := &uploadCounter{API: Fake(nil)}
+	c := NewFileCache(u, nil)
+
+	c.UploadPhoto("1", strings.NewReader("logo"), "", nil)
+	c.UploadPhoto("2", strings.NewReader("logo"), "", nil)
+	c.UploadPhoto("3", strings.NewReader("another logo"), "", nil)
+	c.UploadDocument("1", strings.NewReader("logo"), "", nil)
+	c.UploadDocument("2", strings.NewReader("logo"), "", nil)
+
+	if u.uploads != 3 {
+		t.Errorf("expected 3 uploads, got %d", u.uploads)
+	}
+	if len(u.sent) != 2 || u.sent[0] != "large" || u.sent[1] != "doc" {
+		t.Errorf("expected to send cached [large doc], got %v", u.sent)
+	}
+}
@@ -0,0 +1,91 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// SendGame maps to https://core.telegram.org/bots/api#sendgame
+//
+// game is the short name of the game, set up via BotFather.
+func (a *api) SendGame(chat ChatID, game string, opts *Options) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("game_short_name", game)
+
+	if opts != nil {
+		optBool(params, "disable_notification", opts.Silent)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
+
+		if markup := opts.ReplyMarkup; markup != nil {
+			m, err := markup.Bytes()
+			if err != nil {
+				return nil, err
+			}
+			optJSON(params, "reply_markup", m)
+		}
+	}
+
+	return a.callAndSetMsg("sendGame", params)
+}
+
+// SetGameScore maps to https://core.telegram.org/bots/api#setgamescore
+//
+// Set force to allow decreasing the score, noEdit to keep the game message unchanged.
+func (a *api) SetGameScore(chat ChatID, msg, user int64, score int, force, noEdit bool) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("message_id", strconv.FormatInt(msg, 10))
+	params.Set("user_id", strconv.FormatInt(user, 10))
+	params.Set("score", strconv.Itoa(score))
+	optBool(params, "force", force)
+	optBool(params, "disable_edit_message", noEdit)
+
+	return a.callAndSetMsg("setGameScore", params)
+}
+
+// SetInlineGameScore sets score of inline game message, maps to https://core.telegram.org/bots/api#setgamescore
+//
+// By official documentations, server will return boolean true when setting score of inline message.
+// This method will report json parse error when such situation.
+func (a *api) SetInlineGameScore(msg string, user int64, score int, force, noEdit bool) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("inline_message_id", msg)
+	params.Set("user_id", strconv.FormatInt(user, 10))
+	params.Set("score", strconv.Itoa(score))
+	optBool(params, "force", force)
+	optBool(params, "disable_edit_message", noEdit)
+
+	return a.callAndSetMsg("setGameScore", params)
+}
+
+// GetGameHighScores returns scores of user and several neighbors, maps to https://core.telegram.org/bots/api#getgamehighscores
+func (a *api) GetGameHighScores(chat ChatID, msg, user int64) ([]GameHighScore, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("message_id", strconv.FormatInt(msg, 10))
+	params.Set("user_id", strconv.FormatInt(user, 10))
+
+	var r highScoresResult
+	err := a.callAndSet("getGameHighScores", params, &r)
+	return r.Scores, err
+}
+
+// GetInlineGameHighScores returns scores of inline game message, maps to https://core.telegram.org/bots/api#getgamehighscores
+func (a *api) GetInlineGameHighScores(msg string, user int64) ([]GameHighScore, error) {
+	params := url.Values{}
+
+	params.Set("inline_message_id", msg)
+	params.Set("user_id", strconv.FormatInt(user, 10))
+
+	var r highScoresResult
+	err := a.callAndSet("getGameHighScores", params, &r)
+	return r.Scores, err
+}
@@ -0,0 +1,184 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import "fmt"
+
+// ResultBuilder builds inline query result fluently
+//
+//	r, err := NewPhotoResult("id", photoURL, thumbURL).
+//	    Caption("<b>logo</b>", HTMLMode).
+//	    Buttons(buttons, 2).
+//	    Build()
+//
+// Setters not supported by the result type are reported by Build.
+type ResultBuilder struct {
+	result    InlineQueryResult
+	iqr       *IQR
+	caption   *string
+	parseMode *string
+	err       error
+}
+
+func newResultBuilder(r InlineQueryResult, iqr *IQR, id string) *ResultBuilder {
+	iqr.ID = id
+	return &ResultBuilder{result: r, iqr: iqr}
+}
+
+// withCaption enables Caption for result types supporting it
+func (b *ResultBuilder) withCaption(caption, parseMode *string) *ResultBuilder {
+	b.caption = caption
+	b.parseMode = parseMode
+	return b
+}
+
+// NewArticleResult creates builder of InlineQueryResultArticle
+func NewArticleResult(id, title string, content InputMessageContent) *ResultBuilder {
+	r := &InlineQueryResultArticle{}
+	r.Title = title
+	r.InputMessageContent = content
+	return newResultBuilder(r, &r.IQR, id)
+}
+
+// NewPhotoResult creates builder of InlineQueryResultPhoto
+func NewPhotoResult(id, photoURL, thumbURL string) *ResultBuilder {
+	r := &InlineQueryResultPhoto{URL: photoURL}
+	r.ThumbURL = thumbURL
+	return newResultBuilder(r, &r.IQR, id).withCaption(&r.Caption, &r.ParseMode)
+}
+
+// NewGifResult creates builder of InlineQueryResultGif
+func NewGifResult(id, gifURL, thumbURL string) *ResultBuilder {
+	r := &InlineQueryResultGif{URL: gifURL}
+	r.ThumbURL = thumbURL
+	return newResultBuilder(r, &r.IQR, id).withCaption(&r.Caption, &r.ParseMode)
+}
+
+// NewVideoResult creates builder of InlineQueryResultVideo
+func NewVideoResult(id, videoURL, mimeType, thumbURL, title string) *ResultBuilder {
+	r := &InlineQueryResultVideo{URL: videoURL, MimeType: mimeType}
+	r.ThumbURL = thumbURL
+	r.Title = title
+	return newResultBuilder(r, &r.IQR, id).withCaption(&r.Caption, &r.ParseMode)
+}
+
+// NewAudioResult creates builder of InlineQueryResultAudio
+func NewAudioResult(id, audioURL, title string) *ResultBuilder {
+	r := &InlineQueryResultAudio{URL: audioURL}
+	r.Title = title
+	return newResultBuilder(r, &r.IQR, id).withCaption(&r.Caption, &r.ParseMode)
+}
+
+// NewVoiceResult creates builder of InlineQueryResultVoice
+func NewVoiceResult(id, voiceURL, title string) *ResultBuilder {
+	r := &InlineQueryResultVoice{URL: voiceURL}
+	r.Title = title
+	return newResultBuilder(r, &r.IQR, id).withCaption(&r.Caption, &r.ParseMode)
+}
+
+// NewDocumentResult creates builder of InlineQueryResultDocument
+func NewDocumentResult(id, documentURL, mimeType, title string) *ResultBuilder {
+	r := &InlineQueryResultDocument{URL: documentURL, MimeType: mimeType}
+	r.Title = title
+	return newResultBuilder(r, &r.IQR, id).withCaption(&r.Caption, &r.ParseMode)
+}
+
+// NewLocationResult creates builder of InlineQueryResultLocation
+func NewLocationResult(id string, lat, lng float64, title string) *ResultBuilder {
+	r := &InlineQueryResultLocation{Lat: lat, Lng: lng}
+	r.Title = title
+	return newResultBuilder(r, &r.IQR, id)
+}
+
+// NewVenueResult creates builder of InlineQueryResultVenue
+func NewVenueResult(id string, lat, lng float64, title, address string) *ResultBuilder {
+	r := &InlineQueryResultVenue{Lat: lat, Lng: lng, Address: address}
+	r.Title = title
+	return newResultBuilder(r, &r.IQR, id)
+}
+
+// NewContactResult creates builder of InlineQueryResultContact
+func NewContactResult(id, phoneNumber, firstName, lastName string) *ResultBuilder {
+	r := &InlineQueryResultContact{PhoneNumber: phoneNumber, FirstName: firstName, LastName: lastName}
+	return newResultBuilder(r, &r.IQR, id)
+}
+
+// NewStickerResult creates builder of InlineQueryResultSticker
+func NewStickerResult(id, fileID string) *ResultBuilder {
+	r := &InlineQueryResultSticker{FileID: fileID}
+	return newResultBuilder(r, &r.IQR, id)
+}
+
+// Title sets the title of the result
+func (b *ResultBuilder) Title(title string) *ResultBuilder {
+	b.iqr.Title = title
+	return b
+}
+
+// Description sets short description of the result
+func (b *ResultBuilder) Description(desc string) *ResultBuilder {
+	b.iqr.Description = desc
+	return b
+}
+
+// Thumb sets thumbnail of the result, pass 0 to omit width or height
+func (b *ResultBuilder) Thumb(url string, width, height int) *ResultBuilder {
+	b.iqr.ThumbURL = url
+	b.iqr.ThumbWidth = width
+	b.iqr.ThumbHeight = height
+	return b
+}
+
+// Caption sets caption of the result, see TextMode, HTMLMode and MarkdownMode for parseMode
+func (b *ResultBuilder) Caption(caption, parseMode string) *ResultBuilder {
+	if b.caption == nil {
+		if b.err == nil {
+			b.err = &InvalidResultError{b.iqr.ID, "caption", "is not supported"}
+		}
+		return b
+	}
+
+	*b.caption = caption
+	*b.parseMode = parseMode
+	return b
+}
+
+// Markup sets inline keyboard attached to the message
+func (b *ResultBuilder) Markup(markup *InlineKeyboardMarkup) *ResultBuilder {
+	b.iqr.ReplyMarkup = markup
+	return b
+}
+
+// Buttons sets inline keyboard attached to the message, buttons are formatted by FormatInlineKeyboard
+func (b *ResultBuilder) Buttons(buttons []InlineKeyboardButton, ncol int) *ResultBuilder {
+	return b.Markup(&InlineKeyboardMarkup{Keyboard: FormatInlineKeyboard(buttons, ncol)})
+}
+
+// Content sets content of the message to be sent instead of the result itself
+func (b *ResultBuilder) Content(content InputMessageContent) *ResultBuilder {
+	b.iqr.InputMessageContent = content
+	return b
+}
+
+// Build validates and returns the result
+func (b *ResultBuilder) Build() (InlineQueryResult, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	b.result.ValidateType()
+	if err := b.result.Validate(); err != nil {
+		return nil, err
+	}
+	return b.result, nil
+}
+
+// MustBuild is like Build, but panics if the result is invalid
+func (b *ResultBuilder) MustBuild() InlineQueryResult {
+	r, err := b.Build()
+	if err != nil {
+		panic(fmt.Sprintf("cannot build inline query result: %s", err))
+	}
+	return r
+}
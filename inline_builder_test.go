@@ -0,0 +1,82 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestResultBuilder(t *testing.T) {
+	buttons := []InlineKeyboardButton{
+		InlineKeyboardButton{Text: "a", URL: "https://a"},
+		InlineKeyboardButton{Text: "b", URL: "https://b"},
+		InlineKeyboardButton{Text: "c", URL: "https://c"},
+	}
+
+	tbl := []struct {
+		name    string
+		builder *ResultBuilder
+		expect  string
+	}{
+		{
+			"article",
+			NewArticleResult("1", "title", &TextContent{Text: "text"}).Description("desc"),
+			`{"type":"article","id":"1","input_message_content":{"message_text":"text"},"title":"title","description":"desc"}`,
+		},
+		{
+			"photo",
+			NewPhotoResult("2", "https://photo", "https://thumb").Thumb("https://thumb", 64, 64).Caption("*hi*", MarkdownMode).Buttons(buttons, 2),
+			`{"type":"photo","id":"2","reply_markup":{"inline_keyboard":[[{"text":"a","url":"https://a"},{"text":"b","url":"https://b"}],[{"text":"c","url":"https://c"}]]},"thumb_url":"https://thumb","thumb_width":64,"thumb_height":64,"photo_url":"https://photo","caption":"*hi*","parse_mode":"Markdown"}`,
+		},
+		{
+			"venue",
+			NewVenueResult("3", 25.1, 121.5, "title", "addr").Content(&TextContent{Text: "here"}),
+			`{"type":"venue","id":"3","input_message_content":{"message_text":"here"},"title":"title","latitude":25.1,"longitude":121.5,"address":"addr"}`,
+		},
+		{
+			"sticker",
+			NewStickerResult("4", "file"),
+			`{"type":"sticker","id":"4","sticker_file_id":"file"}`,
+		},
+	}
+
+	for _, data := range tbl {
+		r, err := data.builder.Build()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", data.name, err)
+		}
+		buf, err := json.Marshal(r)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", data.name, err)
+		}
+		if string(buf) != data.expect {
+			t.Errorf("%s: expected %s, got %s", data.name, data.expect, string(buf))
+		}
+	}
+}
+
+func TestResultBuilderInvalid(t *testing.T) {
+	tbl := []struct {
+		name    string
+		builder *ResultBuilder
+		field   string
+	}{
+		{"missing thumb", NewPhotoResult("1", "https://photo", ""), "thumb_url"},
+		{"missing content", NewArticleResult("1", "title", nil), "input_message_content"},
+		{"caption not supported", NewContactResult("1", "123", "first", "").Caption("hi", TextMode), "caption"},
+	}
+
+	for _, data := range tbl {
+		_, err := data.builder.Build()
+		e, ok := err.(*InvalidResultError)
+		if !ok {
+			t.Errorf("%s: expected *InvalidResultError, got %#v", data.name, err)
+			continue
+		}
+		if e.Field != data.field {
+			t.Errorf("%s: expected invalid field %s, got %s", data.name, data.field, e.Field)
+		}
+	}
+}
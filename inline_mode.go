@@ -18,11 +18,16 @@ type InlineQueryOptions struct {
 }
 
 // AnswerInlineQuery maps to https://core.telegram.org/bots/api#answerinlinequery
+//
+// Results are validated by ValidateResults before sending to server.
 func (a *api) AnswerInlineQuery(query string, results []InlineQueryResult, opts *InlineQueryOptions) error {
 	params := url.Values{}
 
 	params.Set("inline_query_id", query)
 
+	if err := ValidateResults(results); err != nil {
+		return err
+	}
 	for idx := range results {
 		results[idx].ValidateType()
 	}
@@ -0,0 +1,108 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import "strconv"
+
+// MaxInlineResults is the maximum number of results allowed in one answer of inline query
+const MaxInlineResults = 50
+
+// InlineResultSource fetches at most limit results for the query, starting from offset.
+//
+// more should be true if there are results after this page.
+type InlineResultSource func(q *InlineQuery, offset, limit int) (results []InlineQueryResult, more bool, err error)
+
+// SliceSource creates InlineResultSource from fixed results
+func SliceSource(results []InlineQueryResult) InlineResultSource {
+	return func(q *InlineQuery, offset, limit int) ([]InlineQueryResult, bool, error) {
+		if offset >= len(results) {
+			return nil, false, nil
+		}
+		end := offset + limit
+		if end >= len(results) {
+			return results[offset:], false, nil
+		}
+		return results[offset:end], true, nil
+	}
+}
+
+// EncodeOffset encodes numeric offset into opaque string for InlineQueryOptions.NextOffset
+func EncodeOffset(offset int) string {
+	if offset <= 0 {
+		return ""
+	}
+	return encodeRawBase64([]byte(strconv.Itoa(offset)))
+}
+
+// DecodeOffset decodes InlineQuery.Offset encoded by EncodeOffset
+func DecodeOffset(offset string) (int, error) {
+	if offset == "" {
+		return 0, nil
+	}
+	buf, err := decodeRawBase64(offset)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(buf))
+}
+
+// InlinePager answers inline queries page by page.
+//
+// Telegram requests next page with the offset we passed as NextOffset, InlinePager
+// encodes and decodes the offset, so Source sees only plain numbers.
+type InlinePager struct {
+	API     API
+	Source  InlineResultSource
+	Size    int                             // results per page, defaults to and capped at MaxInlineResults
+	Options *InlineQueryOptions             // InlineQueryOptions.NextOffset is overwritten
+	OnError func(q *InlineQuery, err error) // called by Handle when failed to answer
+}
+
+func (p *InlinePager) size() int {
+	if p.Size <= 0 || p.Size > MaxInlineResults {
+		return MaxInlineResults
+	}
+	return p.Size
+}
+
+// Answer answers the inline query with the page requested.
+//
+// Malformed offset (which cannot be sent by official clients) restarts from first page.
+func (p *InlinePager) Answer(q *InlineQuery) error {
+	offset, err := DecodeOffset(q.Offset)
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	size := p.size()
+	results, more, err := p.Source(q, offset, size)
+	if err != nil {
+		return err
+	}
+	if len(results) > size {
+		results = results[:size]
+		more = true
+	}
+
+	opts := InlineQueryOptions{}
+	if p.Options != nil {
+		opts = *p.Options
+	}
+	opts.NextOffset = ""
+	if more && len(results) > 0 { // empty page with more results would be requested forever
+		opts.NextOffset = EncodeOffset(offset + len(results))
+	}
+
+	if results == nil {
+		results = []InlineQueryResult{}
+	}
+	return p.API.AnswerInlineQuery(q.ID, results, &opts)
+}
+
+// Handle is an InlineQueryHandler answering the query, errors are passed to OnError
+func (p *InlinePager) Handle(q *InlineQuery) {
+	if err := p.Answer(q); err != nil && p.OnError != nil {
+		p.OnError(q, err)
+	}
+}
@@ -0,0 +1,76 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"strconv"
+	"testing"
+)
+
+type inlineAnswers struct {
+	API
+	results []InlineQueryResult
+	opts    *InlineQueryOptions
+}
+
+func (a *inlineAnswers) AnswerInlineQuery(query string, results []InlineQueryResult, opts *InlineQueryOptions) error {
+	a.results, a.opts = results, opts
+	return nil
+}
+
+func TestInlinePager(t *testing.T) {
+	var results []InlineQueryResult
+	for i := 0; i < 120; i++ {
+		results = append(results, &InlineQueryResultArticle{IQR: IQR{ID: strconv.Itoa(i)}})
+	}
+	a := &inlineAnswers{API: Fake(nil)}
+	p := &InlinePager{API: a, Source: SliceSource(results), Options: &InlineQueryOptions{CacheTime: 10}}
+
+	offset := ""
+	for _, expect := range []int{50, 50, 20} {
+		if err := p.Answer(&InlineQuery{ID: "q", Offset: offset}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(a.results) != expect {
+			t.Fatalf("expected %d results, got %d", expect, len(a.results))
+		}
+		if a.opts.CacheTime != 10 {
+			t.Errorf("expected options to be kept, got %#v", a.opts)
+		}
+		offset = a.opts.NextOffset
+	}
+	if offset != "" {
+		t.Errorf("expected no next offset after last page, got %s", offset)
+	}
+
+	// malformed offset restarts from first page
+	p.Answer(&InlineQuery{ID: "q", Offset: "?!"})
+	if len(a.results) != 50 || a.results[0] != results[0] {
+		t.Errorf("expected first page for malformed offset")
+	}
+
+	// empty page never leads to another request
+	p.Source = func(q *InlineQuery, offset, limit int) ([]InlineQueryResult, bool, error) {
+		return nil, true, nil
+	}
+	p.Answer(&InlineQuery{ID: "q"})
+	if a.opts.NextOffset != "" {
+		t.Errorf("expected no next offset for empty page, got %s", a.opts.NextOffset)
+	}
+}
+
+func TestOffset(t *testing.T) {
+	for _, n := range []int{0, 1, 50, 123456} {
+		if actual, err := DecodeOffset(EncodeOffset(n)); err != nil || actual != n {
+			t.Errorf("expected offset %d, got %d (%v)", n, actual, err)
+		}
+	}
+
+	if actual := EncodeOffset(50); actual != "NTA" {
+		t.Errorf("expected unpadded offset NTA, got %s", actual)
+	}
+	if _, err := DecodeOffset("NTA="); err == nil {
+		t.Errorf("expected padded offset to be rejected")
+	}
+}
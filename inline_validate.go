@@ -0,0 +1,113 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+)
+
+// length limits of inline query results
+const (
+	MaxResultIDLength = 64   // in bytes
+	MaxCaptionLength  = 1024 // in characters
+)
+
+// InvalidResultError reports which field of an inline query result is invalid
+type InvalidResultError struct {
+	ID     string // id of the result
+	Field  string // name of the field in Bot API
+	Reason string
+}
+
+func (e InvalidResultError) Error() string {
+	return fmt.Sprintf("invalid inline query result \"%s\": field %s %s", e.ID, e.Field, e.Reason)
+}
+
+// firstError returns first non-nil error
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r IQR) invalid(field, reason string) error {
+	return &InvalidResultError{r.ID, field, reason}
+}
+
+func (r IQR) validate() error {
+	if r.ID == "" {
+		return r.invalid("id", "is required")
+	}
+	if len(r.ID) > MaxResultIDLength {
+		return r.invalid("id", fmt.Sprintf("exceeds %d bytes", MaxResultIDLength))
+	}
+	return nil
+}
+
+func (r IQR) required(field, val string) error {
+	if val == "" {
+		return r.invalid(field, "is required")
+	}
+	return nil
+}
+
+func (r IQR) requiredContent() error {
+	if r.InputMessageContent == nil {
+		return r.invalid("input_message_content", "is required")
+	}
+	return nil
+}
+
+func (r IQR) caption(caption string) error {
+	if utf8.RuneCountInString(caption) > MaxCaptionLength {
+		return r.invalid("caption", fmt.Sprintf("exceeds %d characters", MaxCaptionLength))
+	}
+	return nil
+}
+
+// ValidateResults validates every result and checks that result ids are unique.
+//
+// It returns error if there are more than MaxInlineResults results.
+func ValidateResults(results []InlineQueryResult) error {
+	if len(results) > MaxInlineResults {
+		return fmt.Errorf("too many inline query results: %d > %d", len(results), MaxInlineResults)
+	}
+
+	ids := map[string]bool{}
+	for idx, r := range results {
+		if r == nil || isNilPointer(r) {
+			return &InvalidResultError{"", "results", fmt.Sprintf("has nil element at %d", idx)}
+		}
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		// results defined outside this package might not embed IQR
+		i, ok := r.(interface{ resultID() string })
+		if !ok {
+			continue
+		}
+		id := i.resultID()
+		if ids[id] {
+			return &InvalidResultError{id, "id", "is duplicated"}
+		}
+		ids[id] = true
+	}
+
+	return nil
+}
+
+func (r IQR) resultID() string {
+	return r.ID
+}
+
+func isNilPointer(r InlineQueryResult) bool {
+	v := reflect.ValueOf(r)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
@@ -0,0 +1,55 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateResults(t *testing.T) {
+	article := func(id string) *InlineQueryResultArticle {
+		return &InlineQueryResultArticle{IQR: IQR{ID: id, Title: "title", InputMessageContent: &TextContent{Text: "text"}}}
+	}
+	photo := &InlineQueryResultPhoto{IQR: IQR{ID: "photo", ThumbURL: "thumb"}, URL: "url", Caption: strings.Repeat("字", MaxCaptionLength+1)}
+
+	tbl := []struct {
+		name    string
+		results []InlineQueryResult
+		field   string
+	}{
+		{"valid", []InlineQueryResult{article("1"), article("2")}, ""},
+		{"missing id", []InlineQueryResult{article("")}, "id"},
+		{"long id", []InlineQueryResult{article(strings.Repeat("a", MaxResultIDLength+1))}, "id"},
+		{"duplicated id", []InlineQueryResult{article("1"), article("1")}, "id"},
+		{"missing content", []InlineQueryResult{&InlineQueryResultArticle{IQR: IQR{ID: "1", Title: "title"}}}, "input_message_content"},
+		{"missing file id", []InlineQueryResult{&InlineQueryResultCachedAudio{IQR: IQR{ID: "1"}}}, "audio_file_id"},
+		{"long caption", []InlineQueryResult{photo}, "caption"},
+		{"nil result", []InlineQueryResult{article("1"), nil}, "results"},
+		{"nil pointer", []InlineQueryResult{(*InlineQueryResultArticle)(nil)}, "results"},
+	}
+
+	for _, data := range tbl {
+		err := ValidateResults(data.results)
+		if data.field == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", data.name, err)
+			}
+			continue
+		}
+
+		e, ok := err.(*InvalidResultError)
+		if !ok {
+			t.Errorf("%s: expected *InvalidResultError, got %#v", data.name, err)
+			continue
+		}
+		if e.Field != data.field {
+			t.Errorf("%s: expected invalid field %s, got %s", data.name, data.field, e.Field)
+		}
+	}
+
+	if err := ValidateResults(make([]InlineQueryResult, MaxInlineResults+1)); err == nil {
+		t.Errorf("expected error for too many results")
+	}
+}
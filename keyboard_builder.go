@@ -0,0 +1,173 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxCallbackDataLength is the maximum length of InlineKeyboardButton.Data in bytes
+const MaxCallbackDataLength = 64
+
+// URLButton creates a button opening the url
+func URLButton(text, url string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, URL: url}
+}
+
+// CallbackButton creates a button sending CallbackQuery with data to the bot
+func CallbackButton(text, data string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, Data: data}
+}
+
+// SwitchButton creates a button prompting the user to select a chat, and inserting bot username and query in it
+func SwitchButton(text, query string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, Switch: &query}
+}
+
+// SwitchCurrentChatButton creates a button inserting bot username and query in current chat
+func SwitchCurrentChatButton(text, query string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, SwitchCurrentChat: &query}
+}
+
+// LoginButton creates a button authorizing the user with Telegram Login Widget
+func LoginButton(text string, login *LoginURL) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, LoginURL: login}
+}
+
+// WebAppButton creates a button launching the Web App
+func WebAppButton(text, url string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, WebApp: &WebAppInfo{URL: url}}
+}
+
+// GameButton creates a button launching the game
+func GameButton(text string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, Game: &CallbackGame{}}
+}
+
+// PayButton creates a button for paying invoice
+func PayButton(text string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, Pay: true}
+}
+
+// CopyButton creates a button copying copy to the clipboard
+func CopyButton(text, copy string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, CopyText: &CopyTextButton{Text: copy}}
+}
+
+// Validate checks that exactly one optional field is set
+func (b InlineKeyboardButton) Validate() error {
+	if b.Text == "" {
+		return errors.New("inline keyboard button: text is required")
+	}
+
+	n := 0
+	for _, set := range []bool{
+		b.URL != "",
+		b.Data != "",
+		b.Switch != nil,
+		b.SwitchCurrentChat != nil,
+		b.LoginURL != nil,
+		b.WebApp != nil,
+		b.Game != nil,
+		b.Pay,
+		b.CopyText != nil,
+	} {
+		if set {
+			n++
+		}
+	}
+	if n != 1 {
+		return fmt.Errorf("inline keyboard button \"%s\": expected exactly one optional field, got %d", b.Text, n)
+	}
+
+	if len(b.Data) > MaxCallbackDataLength {
+		return fmt.Errorf("inline keyboard button \"%s\": callback data exceeds %d bytes", b.Text, MaxCallbackDataLength)
+	}
+
+	return nil
+}
+
+// InlineKeyboardBuilder builds InlineKeyboardMarkup row by row
+//
+//	markup, err := NewInlineKeyboard().
+//		Row(URLButton("site", url)).
+//		Layout(buttons, 1, 3).
+//		Navigation(&prev, &next).
+//		Markup()
+type InlineKeyboardBuilder struct {
+	rows [][]InlineKeyboardButton
+}
+
+// NewInlineKeyboard creates an empty InlineKeyboardBuilder
+func NewInlineKeyboard() *InlineKeyboardBuilder {
+	return &InlineKeyboardBuilder{}
+}
+
+// Row appends a row, empty row is ignored
+func (k *InlineKeyboardBuilder) Row(buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	if len(buttons) > 0 {
+		k.rows = append(k.rows, buttons)
+	}
+	return k
+}
+
+// Grid appends buttons as rows of ncol buttons, see FormatInlineKeyboard
+func (k *InlineKeyboardBuilder) Grid(buttons []InlineKeyboardButton, ncol int) *InlineKeyboardBuilder {
+	k.rows = append(k.rows, FormatInlineKeyboard(buttons, ncol)...)
+	return k
+}
+
+// Layout appends buttons as rows of specified widths, last width is repeated for remaining buttons
+//
+// Say you have buttons [1, 2, 3, 4, 5, 6], by calling this method with widths=[1, 2], it
+// appends [[1], [2, 3], [4, 5], [6]]
+func (k *InlineKeyboardBuilder) Layout(buttons []InlineKeyboardButton, widths ...int) *InlineKeyboardBuilder {
+	if len(widths) == 0 {
+		return k.Row(buttons...)
+	}
+
+	for i := 0; len(buttons) > 0; i++ {
+		w := widths[len(widths)-1]
+		if i < len(widths) {
+			w = widths[i]
+		}
+		if w < 1 {
+			w = 1
+		}
+		if w > len(buttons) {
+			w = len(buttons)
+		}
+		k.Row(buttons[:w]...)
+		buttons = buttons[w:]
+	}
+	return k
+}
+
+// Navigation appends a row with prev and next buttons, nil buttons are omitted
+func (k *InlineKeyboardBuilder) Navigation(prev, next *InlineKeyboardButton) *InlineKeyboardBuilder {
+	row := []InlineKeyboardButton{}
+	for _, b := range []*InlineKeyboardButton{prev, next} {
+		if b != nil {
+			row = append(row, *b)
+		}
+	}
+	return k.Row(row...)
+}
+
+// Markup validates all buttons and returns the keyboard
+func (k *InlineKeyboardBuilder) Markup() (*InlineKeyboardMarkup, error) {
+	for y, row := range k.rows {
+		for x, b := range row {
+			if err := b.Validate(); err != nil {
+				return nil, err
+			}
+			if (b.Game != nil || b.Pay) && (x != 0 || y != 0) {
+				return nil, fmt.Errorf("inline keyboard button \"%s\": must be the first button in the first row", b.Text)
+			}
+		}
+	}
+
+	return &InlineKeyboardMarkup{Keyboard: k.rows}, nil
+}
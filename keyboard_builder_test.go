@@ -0,0 +1,62 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInlineKeyboardBuilder(t *testing.T) {
+	buttons := []InlineKeyboardButton{
+		CallbackButton("1", "1"),
+		CallbackButton("2", "2"),
+		CallbackButton("3", "3"),
+		CallbackButton("4", "4"),
+		CallbackButton("5", "5"),
+		CallbackButton("6", "6"),
+	}
+	prev := CallbackButton("prev", "prev")
+
+	m, err := NewInlineKeyboard().
+		Row(PayButton("pay"), CopyButton("copy", "text")).
+		Row(SwitchButton("share", ""), SwitchCurrentChatButton("search", "q")).
+		Layout(buttons, 1, 2).
+		Navigation(&prev, nil).
+		Markup()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	buf, _ := m.Bytes()
+	expect := `{"inline_keyboard":[` +
+		`[{"text":"pay","pay":true},{"text":"copy","copy_text":{"text":"text"}}],` +
+		`[{"text":"share","switch_inline_query":""},{"text":"search","switch_inline_query_current_chat":"q"}],` +
+		`[{"text":"1","callback_data":"1"}],` +
+		`[{"text":"2","callback_data":"2"},{"text":"3","callback_data":"3"}],` +
+		`[{"text":"4","callback_data":"4"},{"text":"5","callback_data":"5"}],` +
+		`[{"text":"6","callback_data":"6"}],` +
+		`[{"text":"prev","callback_data":"prev"}]]}`
+	if string(buf) != expect {
+		t.Errorf("expected %s, got %s", expect, string(buf))
+	}
+}
+
+func TestInlineKeyboardBuilderInvalid(t *testing.T) {
+	tbl := []struct {
+		name    string
+		builder *InlineKeyboardBuilder
+	}{
+		{"no optional field", NewInlineKeyboard().Row(InlineKeyboardButton{Text: "a"})},
+		{"two optional fields", NewInlineKeyboard().Row(InlineKeyboardButton{Text: "a", URL: "https://a", Data: "a"})},
+		{"long data", NewInlineKeyboard().Row(CallbackButton("a", strings.Repeat("a", MaxCallbackDataLength+1)))},
+		{"switch and url", NewInlineKeyboard().Row(InlineKeyboardButton{Text: "a", URL: "https://a", Switch: new(string)})},
+		{"game not first", NewInlineKeyboard().Row(URLButton("a", "https://a"), GameButton("play"))},
+	}
+
+	for _, data := range tbl {
+		if _, err := data.builder.Markup(); err == nil {
+			t.Errorf("%s: expected error", data.name)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MenuItem is an item listed in Menu, Action is passed to Menu.OnSelect when the item is clicked.
+//
+// Action must not be empty, which is reserved for navigation buttons.
+type MenuItem struct {
+	Text   string
+	Action string
+}
+
+// MenuSource fetches at most limit items, starting from offset.
+//
+// more should be true if there are items after this page.
+type MenuSource func(offset, limit int) (items []MenuItem, more bool, err error)
+
+// MenuItems creates MenuSource from fixed items
+func MenuItems(items []MenuItem) MenuSource {
+	return func(offset, limit int) ([]MenuItem, bool, error) {
+		if offset >= len(items) {
+			return nil, false, nil
+		}
+		end := offset + limit
+		if end >= len(items) {
+			return items[offset:], false, nil
+		}
+		return items[offset:end], true, nil
+	}
+}
+
+// Menu is an inline keyboard listing items page by page, with prev/next buttons.
+//
+// Callback data of the buttons is formatted as "name:action:page", empty action for
+// navigation. Name must not contain colon, and the whole data must fit
+// in MaxCallbackDataLength bytes. Register Handle to Router with Name:
+//
+//	r.HandleCallbackQuery(menu.Name, menu.Handle)
+type Menu struct {
+	API      API
+	Name     string
+	Source   MenuSource
+	Size     int    // items per page, defaults to 10
+	Columns  int    // items per row, defaults to 1
+	PrevText string // text of prev button, defaults to "<"
+	NextText string // text of next button, defaults to ">"
+
+	// OnSelect is called when an item is clicked, answer is sent by AnswerCallbackQuery
+	OnSelect func(q *CallbackQuery, action string, page int) (answer string, err error)
+	// OnError is called by Handle when failed to process the callback query
+	OnError func(q *CallbackQuery, err error)
+}
+
+// ErrMalformedMenuData is returned if callback data is not generated by the Menu
+var ErrMalformedMenuData = errors.New("malformed menu callback data")
+
+func (m *Menu) size() int {
+	if m.Size <= 0 {
+		return 10
+	}
+	return m.Size
+}
+
+func (m *Menu) columns() int {
+	if m.Columns <= 0 {
+		return 1
+	}
+	return m.Columns
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+func (m *Menu) data(action string, page int) string {
+	return m.Name + ":" + action + ":" + strconv.Itoa(page)
+}
+
+// parse extracts action and page from callback data
+func (m *Menu) parse(data string) (action string, page int, err error) {
+	if !strings.HasPrefix(data, m.Name+":") {
+		return "", 0, ErrMalformedMenuData
+	}
+	data = data[len(m.Name)+1:]
+
+	idx := strings.LastIndex(data, ":")
+	if idx < 0 {
+		return "", 0, ErrMalformedMenuData
+	}
+	if page, err = strconv.Atoi(data[idx+1:]); err != nil || page < 0 {
+		return "", 0, ErrMalformedMenuData
+	}
+
+	return data[:idx], page, nil
+}
+
+// Page renders specified page (zero-based) as inline keyboard, send it with the first message
+func (m *Menu) Page(page int) (*InlineKeyboardMarkup, error) {
+	if page < 0 {
+		page = 0
+	}
+	size := m.size()
+	items, more, err := m.Source(page*size, size)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) > size {
+		items = items[:size]
+		more = true
+	}
+
+	buttons := make([]InlineKeyboardButton, 0, len(items))
+	for _, i := range items {
+		if i.Action == "" {
+			return nil, fmt.Errorf("menu item \"%s\" has empty action", i.Text)
+		}
+		buttons = append(buttons, CallbackButton(i.Text, m.data(i.Action, page)))
+	}
+
+	var prev, next *InlineKeyboardButton
+	if page > 0 {
+		b := CallbackButton(orDefault(m.PrevText, "<"), m.data("", page-1))
+		prev = &b
+	}
+	if more {
+		b := CallbackButton(orDefault(m.NextText, ">"), m.data("", page+1))
+		next = &b
+	}
+
+	return NewInlineKeyboard().
+		Grid(buttons, m.columns()).
+		Navigation(prev, next).
+		Markup()
+}
+
+// Respond processes the callback query: navigation buttons edit the message in place,
+// other buttons are passed to OnSelect. The query is answered in both cases.
+func (m *Menu) Respond(q *CallbackQuery) error {
+	action, page, err := m.parse(q.Data)
+	if err != nil {
+		return err
+	}
+
+	if action != "" {
+		answer := ""
+		if m.OnSelect != nil {
+			if answer, err = m.OnSelect(q, action, page); err != nil {
+				return err
+			}
+		}
+		return m.API.AnswerCallbackQuery(q.ID, answer, false, nil)
+	}
+
+	markup, err := m.Page(page)
+	if err != nil {
+		return err
+	}
+	if q.Message != nil {
+		_, err = m.API.EditMarkup(q.Message.Chat.ChatID(), q.Message.ID, markup)
+	} else {
+		_, err = m.API.EditInlineMarkup(q.InlineID, markup)
+	}
+	if err != nil {
+		return err
+	}
+
+	return m.API.AnswerCallbackQuery(q.ID, "", false, nil)
+}
+
+// Handle is a CallbackQueryHandler processing the query, errors are passed to OnError
+func (m *Menu) Handle(q *CallbackQuery) {
+	if err := m.Respond(q); err != nil && m.OnError != nil {
+		m.OnError(q, err)
+	}
+}
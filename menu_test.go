@@ -0,0 +1,93 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"strconv"
+	"testing"
+)
+
+type menuRecorder struct {
+	API
+	markup  *InlineKeyboardMarkup
+	inline  string
+	answers []string
+}
+
+func (r *menuRecorder) EditMarkup(chat ChatID, msg int64, markup ReplyMarkup) (*Message, error) {
+	r.markup = markup.(*InlineKeyboardMarkup)
+	return &Message{}, nil
+}
+
+func (r *menuRecorder) EditInlineMarkup(msg string, markup ReplyMarkup) (*Message, error) {
+	r.inline = msg
+	r.markup = markup.(*InlineKeyboardMarkup)
+	return &Message{}, nil
+}
+
+func (r *menuRecorder) AnswerCallbackQuery(query, text string, alert bool, opts *CallbackQueryOptions) error {
+	r.answers = append(r.answers, text)
+	return nil
+}
+
+func TestMenu(t *testing.T) {
+	items := []MenuItem{}
+	for i := 0; i < 5; i++ {
+		items = append(items, MenuItem{Text: strconv.Itoa(i), Action: "item" + strconv.Itoa(i)})
+	}
+	rec := &menuRecorder{API: Fake(nil)}
+	m := &Menu{
+		API:    rec,
+		Name:   "list",
+		Source: MenuItems(items),
+		Size:   2,
+		OnSelect: func(q *CallbackQuery, action string, page int) (string, error) {
+			return action + "@" + strconv.Itoa(page), nil
+		},
+	}
+
+	first, err := m.Page(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if l := len(first.Keyboard); l != 3 || first.Keyboard[2][0].Data != "list::1" {
+		t.Fatalf("unexpected first page: %#v", first.Keyboard)
+	}
+
+	msg := &Message{ID: 1, Chat: &Chat{ID: 1}}
+	if err = m.Respond(&CallbackQuery{Message: msg, Data: "list::1"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	nav := rec.markup.Keyboard[2]
+	if len(nav) != 2 || nav[0].Data != "list::0" || nav[1].Data != "list::2" {
+		t.Errorf("unexpected navigation row of second page: %#v", nav)
+	}
+
+	if err = m.Respond(&CallbackQuery{InlineID: "inline", Data: "list::2"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if l := len(rec.markup.Keyboard); rec.inline != "inline" || l != 2 {
+		t.Errorf("unexpected last page: %#v", rec.markup.Keyboard)
+	}
+
+	if err = m.Respond(&CallbackQuery{Message: msg, Data: "list:item3:1"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if l := len(rec.answers); l != 3 || rec.answers[2] != "item3@1" {
+		t.Errorf("unexpected answers: %v", rec.answers)
+	}
+
+	for _, data := range []string{"list", "other::1", "list::x", "list::-1"} {
+		if err = m.Respond(&CallbackQuery{Data: data}); err != ErrMalformedMenuData {
+			t.Errorf("expected ErrMalformedMenuData for %s, got %v", data, err)
+		}
+	}
+}
+
+func TestMenuEmptyAction(t *testing.T) {
+	m := &Menu{Name: "list", Source: MenuItems([]MenuItem{MenuItem{Text: "no action"}})}
+	if _, err := m.Page(0); err == nil {
+		t.Errorf("expected error for item with empty action")
+	}
+}
@@ -4,6 +4,7 @@
 package telegram
 
 import (
+	"encoding/json"
 	"io"
 	"net/url"
 	"strconv"
@@ -17,16 +18,16 @@ const (
 )
 
 // SendMessage maps to https://core.telegram.org/bots/api#sendmessage
-func (a *api) SendMessage(chat, text string, opts *Options) (*Message, error) {
+func (a *api) SendMessage(chat ChatID, text string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	params.Set("text", text)
 	if opts != nil {
 		optStr(params, "parse_mode", opts.ParseMode)
 		optBool(params, "disable_web_page_preview", opts.NoPreview)
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -41,28 +42,28 @@ func (a *api) SendMessage(chat, text string, opts *Options) (*Message, error) {
 }
 
 // ForwardMessage maps to https://core.telegram.org/bots/api#forwardmessage
-func (a *api) ForwardMessage(to, from string, silent bool, message int) (*Message, error) {
+func (a *api) ForwardMessage(to, from ChatID, silent bool, message int64) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", to)
-	params.Set("from_chat_id", from)
+	params.Set("chat_id", string(to))
+	params.Set("from_chat_id", string(from))
 	optBool(params, "disable_notification", silent)
-	params.Set("message_id", strconv.Itoa(message))
+	params.Set("message_id", strconv.FormatInt(message, 10))
 
 	return a.callAndSetMsg("forwardMessage", params)
 }
 
 // SendPhoto sends cached photo, maps to https://core.telegram.org/bots/api#sendphoto
-func (a *api) SendPhoto(chat, photo, caption string, opts *Options) (*Message, error) {
+func (a *api) SendPhoto(chat ChatID, photo, caption string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	params.Set("photo", photo)
 	optStr(params, "caption", caption)
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -77,15 +78,15 @@ func (a *api) SendPhoto(chat, photo, caption string, opts *Options) (*Message, e
 }
 
 // UploadPhoto uploads a photo and send it, maps to https://core.telegram.org/bots/api#sendphoto
-func (a *api) UploadPhoto(chat string, photo io.Reader, caption string, opts *Options) (*Message, error) {
+func (a *api) UploadPhoto(chat ChatID, photo io.Reader, caption string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	optStr(params, "caption", caption)
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -100,10 +101,10 @@ func (a *api) UploadPhoto(chat string, photo io.Reader, caption string, opts *Op
 }
 
 // SendAudio sends cached audio, maps to https://core.telegram.org/bots/api#sendaudio
-func (a *api) SendAudio(chat, audio string, duration int, performer, title string, opts *Options) (*Message, error) {
+func (a *api) SendAudio(chat ChatID, audio string, duration int, performer, title string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	params.Set("audio", audio)
 	optInt(params, "duration", duration)
 	optStr(params, "performer", performer)
@@ -111,7 +112,7 @@ func (a *api) SendAudio(chat, audio string, duration int, performer, title strin
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -126,17 +127,17 @@ func (a *api) SendAudio(chat, audio string, duration int, performer, title strin
 }
 
 // UploadAudio sends cached audio, maps to https://core.telegram.org/bots/api#sendaudio
-func (a *api) UploadAudio(chat string, audio io.Reader, duration int, performer, title string, opts *Options) (*Message, error) {
+func (a *api) UploadAudio(chat ChatID, audio io.Reader, duration int, performer, title string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	optInt(params, "duration", duration)
 	optStr(params, "performer", performer)
 	optStr(params, "title", title)
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -151,16 +152,16 @@ func (a *api) UploadAudio(chat string, audio io.Reader, duration int, performer,
 }
 
 // SendDocument sends cached document, maps to https://core.telegram.org/bots/api#senddocument
-func (a *api) SendDocument(chat, document, caption string, opts *Options) (*Message, error) {
+func (a *api) SendDocument(chat ChatID, document, caption string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	params.Set("document", document)
 	optStr(params, "caption", caption)
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -175,15 +176,15 @@ func (a *api) SendDocument(chat, document, caption string, opts *Options) (*Mess
 }
 
 // UploadDocument uploads a document and send it, maps to https://core.telegram.org/bots/api#senddocument
-func (a *api) UploadDocument(chat string, document io.Reader, caption string, opts *Options) (*Message, error) {
+func (a *api) UploadDocument(chat ChatID, document io.Reader, caption string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	optStr(params, "caption", caption)
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -198,16 +199,16 @@ func (a *api) UploadDocument(chat string, document io.Reader, caption string, op
 }
 
 // SendSticker sends cached sticker, maps to https://core.telegram.org/bots/api#sendsticker
-func (a *api) SendSticker(chat, sticker, caption string, opts *Options) (*Message, error) {
+func (a *api) SendSticker(chat ChatID, sticker, caption string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	params.Set("sticker", sticker)
 	optStr(params, "caption", caption)
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -222,15 +223,15 @@ func (a *api) SendSticker(chat, sticker, caption string, opts *Options) (*Messag
 }
 
 // UploadSticker uploads a sticker and send it, maps to https://core.telegram.org/bots/api#sendsticker
-func (a *api) UploadSticker(chat string, sticker io.Reader, caption string, opts *Options) (*Message, error) {
+func (a *api) UploadSticker(chat ChatID, sticker io.Reader, caption string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	optStr(params, "caption", caption)
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -245,10 +246,10 @@ func (a *api) UploadSticker(chat string, sticker io.Reader, caption string, opts
 }
 
 // SendVideo sends cached video, maps to https://core.telegram.org/bots/api#sendvideo
-func (a *api) SendVideo(chat, video string, duration, width, height int, caption string, opts *Options) (*Message, error) {
+func (a *api) SendVideo(chat ChatID, video string, duration, width, height int, caption string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	params.Set("video", video)
 	optInt(params, "duration", duration)
 	optInt(params, "width", width)
@@ -257,7 +258,7 @@ func (a *api) SendVideo(chat, video string, duration, width, height int, caption
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -272,10 +273,10 @@ func (a *api) SendVideo(chat, video string, duration, width, height int, caption
 }
 
 // UploadVideo uploads a video and send it, maps to https://core.telegram.org/bots/api#sendvideo
-func (a *api) UploadVideo(chat string, video io.Reader, duration, width, height int, caption string, opts *Options) (*Message, error) {
+func (a *api) UploadVideo(chat ChatID, video io.Reader, duration, width, height int, caption string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	optInt(params, "duration", duration)
 	optInt(params, "width", width)
 	optInt(params, "height", height)
@@ -283,7 +284,7 @@ func (a *api) UploadVideo(chat string, video io.Reader, duration, width, height
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -298,16 +299,16 @@ func (a *api) UploadVideo(chat string, video io.Reader, duration, width, height
 }
 
 // SendVoice sends cached voice, maps to https://core.telegram.org/bots/api#sendvoice
-func (a *api) SendVoice(chat, voice string, duration int, opts *Options) (*Message, error) {
+func (a *api) SendVoice(chat ChatID, voice string, duration int, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	params.Set("voice", voice)
 	optInt(params, "duration", duration)
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -322,15 +323,15 @@ func (a *api) SendVoice(chat, voice string, duration int, opts *Options) (*Messa
 }
 
 // UploadVoice uploads a voice and send it, maps to https://core.telegram.org/bots/api#sendvoice
-func (a *api) UploadVoice(chat string, voice io.Reader, duration int, opts *Options) (*Message, error) {
+func (a *api) UploadVoice(chat ChatID, voice io.Reader, duration int, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	optInt(params, "duration", duration)
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -344,17 +345,171 @@ func (a *api) UploadVoice(chat string, voice io.Reader, duration int, opts *Opti
 	return a.uploadAndSetMsg("sendVoice", params, "voice", voice)
 }
 
+// SendAnimation sends cached animation, maps to https://core.telegram.org/bots/api#sendanimation
+func (a *api) SendAnimation(chat ChatID, animation string, duration, width, height int, caption string, opts *Options) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("animation", animation)
+	optInt(params, "duration", duration)
+	optInt(params, "width", width)
+	optInt(params, "height", height)
+	optStr(params, "caption", caption)
+
+	if opts != nil {
+		optBool(params, "disable_notification", opts.Silent)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
+
+		if markup := opts.ReplyMarkup; markup != nil {
+			m, err := markup.Bytes()
+			if err != nil {
+				return nil, err
+			}
+			optJSON(params, "reply_markup", m)
+		}
+	}
+
+	return a.callAndSetMsg("sendAnimation", params)
+}
+
+// UploadAnimation uploads an animation and send it, maps to https://core.telegram.org/bots/api#sendanimation
+func (a *api) UploadAnimation(chat ChatID, animation io.Reader, duration, width, height int, caption string, opts *Options) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	optInt(params, "duration", duration)
+	optInt(params, "width", width)
+	optInt(params, "height", height)
+	optStr(params, "caption", caption)
+
+	if opts != nil {
+		optBool(params, "disable_notification", opts.Silent)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
+
+		if markup := opts.ReplyMarkup; markup != nil {
+			m, err := markup.Bytes()
+			if err != nil {
+				return nil, err
+			}
+			optJSON(params, "reply_markup", m)
+		}
+	}
+
+	return a.uploadAndSetMsg("sendAnimation", params, "animation", animation)
+}
+
+// SendVideoNote sends cached video note, maps to https://core.telegram.org/bots/api#sendvideonote
+func (a *api) SendVideoNote(chat ChatID, videoNote string, duration, length int, opts *Options) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("video_note", videoNote)
+	optInt(params, "duration", duration)
+	optInt(params, "length", length)
+
+	if opts != nil {
+		optBool(params, "disable_notification", opts.Silent)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
+
+		if markup := opts.ReplyMarkup; markup != nil {
+			m, err := markup.Bytes()
+			if err != nil {
+				return nil, err
+			}
+			optJSON(params, "reply_markup", m)
+		}
+	}
+
+	return a.callAndSetMsg("sendVideoNote", params)
+}
+
+// UploadVideoNote uploads a video note and send it, maps to https://core.telegram.org/bots/api#sendvideonote
+func (a *api) UploadVideoNote(chat ChatID, videoNote io.Reader, duration, length int, opts *Options) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	optInt(params, "duration", duration)
+	optInt(params, "length", length)
+
+	if opts != nil {
+		optBool(params, "disable_notification", opts.Silent)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
+
+		if markup := opts.ReplyMarkup; markup != nil {
+			m, err := markup.Bytes()
+			if err != nil {
+				return nil, err
+			}
+			optJSON(params, "reply_markup", m)
+		}
+	}
+
+	return a.uploadAndSetMsg("sendVideoNote", params, "video_note", videoNote)
+}
+
+// SendDice sends an animated emoji with random value, maps to https://core.telegram.org/bots/api#senddice
+//
+// Pass empty emoji to use default one (DiceEmoji).
+func (a *api) SendDice(chat ChatID, emoji string, opts *Options) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	optStr(params, "emoji", emoji)
+
+	if opts != nil {
+		optBool(params, "disable_notification", opts.Silent)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
+
+		if markup := opts.ReplyMarkup; markup != nil {
+			m, err := markup.Bytes()
+			if err != nil {
+				return nil, err
+			}
+			optJSON(params, "reply_markup", m)
+		}
+	}
+
+	return a.callAndSetMsg("sendDice", params)
+}
+
 // SendLocation maps to https://core.telegram.org/bots/api#sendlocation
-func (a *api) SendLocation(chat string, lat, lng float64, opts *Options) (*Message, error) {
+func (a *api) SendLocation(chat ChatID, lat, lng float64, opts *Options) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("latitude", strconv.FormatFloat(lat, 'f', -1, 64))
+	params.Set("longitude", strconv.FormatFloat(lng, 'f', -1, 64))
+
+	if opts != nil {
+		optBool(params, "disable_notification", opts.Silent)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
+
+		if markup := opts.ReplyMarkup; markup != nil {
+			m, err := markup.Bytes()
+			if err != nil {
+				return nil, err
+			}
+			optJSON(params, "reply_markup", m)
+		}
+	}
+
+	return a.callAndSetMsg("sendLocation", params)
+}
+
+// SendLiveLocation sends a location which can be updated for livePeriod seconds, maps to https://core.telegram.org/bots/api#sendlocation
+//
+// Use EditLiveLocation to update it, and StopLiveLocation to stop it before livePeriod expires.
+func (a *api) SendLiveLocation(chat ChatID, lat, lng float64, livePeriod int, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	params.Set("latitude", strconv.FormatFloat(lat, 'f', -1, 64))
 	params.Set("longitude", strconv.FormatFloat(lng, 'f', -1, 64))
+	params.Set("live_period", strconv.Itoa(livePeriod))
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -369,10 +524,10 @@ func (a *api) SendLocation(chat string, lat, lng float64, opts *Options) (*Messa
 }
 
 // SendVenue maps to https://core.telegram.org/bots/api#sendvenue
-func (a *api) SendVenue(chat string, lat, lng float64, title, addr, foursq string, opts *Options) (*Message, error) {
+func (a *api) SendVenue(chat ChatID, lat, lng float64, title, addr, foursq string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	params.Set("latitude", strconv.FormatFloat(lat, 'f', -1, 64))
 	params.Set("longitude", strconv.FormatFloat(lng, 'f', -1, 64))
 	params.Set("title", title)
@@ -381,7 +536,7 @@ func (a *api) SendVenue(chat string, lat, lng float64, title, addr, foursq strin
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -396,17 +551,17 @@ func (a *api) SendVenue(chat string, lat, lng float64, title, addr, foursq strin
 }
 
 // SendContact maps to https://core.telegram.org/bots/api#sendcontact
-func (a *api) SendContact(chat, phone, firstName, lastName string, opts *Options) (*Message, error) {
+func (a *api) SendContact(chat ChatID, phone, firstName, lastName string, opts *Options) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	params.Set("phone_number", phone)
 	params.Set("first_name", firstName)
 	optStr(params, "last_name", lastName)
 
 	if opts != nil {
 		optBool(params, "disable_notification", opts.Silent)
-		optInt(params, "reply_to_message_id", opts.ReplyID)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
 
 		if markup := opts.ReplyMarkup; markup != nil {
 			m, err := markup.Bytes()
@@ -420,11 +575,69 @@ func (a *api) SendContact(chat, phone, firstName, lastName string, opts *Options
 	return a.callAndSetMsg("sendContact", params)
 }
 
+// PollOptions represents optional parameters for api method sendPoll
+type PollOptions struct {
+	Type                 string // RegularPoll or QuizPoll, defaults to RegularPoll
+	NotAnonymous         bool
+	MultipleAnswers      bool // ignored in quiz mode
+	CorrectOption        int  // 0-based index of correct answer, required in quiz mode
+	Explanation          string
+	ExplanationParseMode string
+	OpenPeriod           int   // in seconds, 5-600, cannot be used together with CloseDate
+	CloseDate            int64 // unix timestamp
+	Closed               bool
+}
+
+// SendPoll maps to https://core.telegram.org/bots/api#sendpoll
+func (a *api) SendPoll(chat ChatID, question string, answers []string, pollOpts *PollOptions, opts *Options) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("question", question)
+
+	buf, err := json.Marshal(answers)
+	if err != nil {
+		return nil, err
+	}
+	params.Set("options", string(buf))
+
+	if pollOpts != nil {
+		optStr(params, "type", pollOpts.Type)
+		if pollOpts.NotAnonymous {
+			params.Set("is_anonymous", "false")
+		}
+		optBool(params, "allows_multiple_answers", pollOpts.MultipleAnswers)
+		if pollOpts.Type == QuizPoll {
+			params.Set("correct_option_id", strconv.Itoa(pollOpts.CorrectOption))
+		}
+		optStr(params, "explanation", pollOpts.Explanation)
+		optStr(params, "explanation_parse_mode", pollOpts.ExplanationParseMode)
+		optInt(params, "open_period", pollOpts.OpenPeriod)
+		optInt64(params, "close_date", pollOpts.CloseDate)
+		optBool(params, "is_closed", pollOpts.Closed)
+	}
+
+	if opts != nil {
+		optBool(params, "disable_notification", opts.Silent)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
+
+		if markup := opts.ReplyMarkup; markup != nil {
+			m, err := markup.Bytes()
+			if err != nil {
+				return nil, err
+			}
+			optJSON(params, "reply_markup", m)
+		}
+	}
+
+	return a.callAndSetMsg("sendPoll", params)
+}
+
 // SendChatAction maps to https://core.telegram.org/bots/api#sendchataction
-func (a *api) SendChatAction(chat, action string) error {
+func (a *api) SendChatAction(chat ChatID, action string) error {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
+	params.Set("chat_id", string(chat))
 	params.Set("action", action)
 
 	return a.callAndSet("sendChatAction", params, nil)
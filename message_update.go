@@ -12,11 +12,11 @@ import (
 //
 // By official documentations, server will return boolean true when editing the message sent by others.
 // This method will report json parse error when such situation.
-func (a *api) EditText(chat string, msg int, text, mode string, noPreview bool, markup ReplyMarkup) (*Message, error) {
+func (a *api) EditText(chat ChatID, msg int64, text, mode string, noPreview bool, markup ReplyMarkup) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
-	params.Set("message_id", strconv.Itoa(msg))
+	params.Set("chat_id", string(chat))
+	params.Set("message_id", strconv.FormatInt(msg, 10))
 	params.Set("text", text)
 	optStr(params, "parse_mode", mode)
 	optBool(params, "disable_Web_page_preview", noPreview)
@@ -59,11 +59,11 @@ func (a *api) EditInlineText(msg, text, mode string, noPreview bool, markup Repl
 //
 // By official documentations, server will return boolean true when editing the message sent by others.
 // This method will report json parse error when such situation.
-func (a *api) EditCaption(chat string, msg int, caption, mode string, noPreview bool, markup ReplyMarkup) (*Message, error) {
+func (a *api) EditCaption(chat ChatID, msg int64, caption, mode string, noPreview bool, markup ReplyMarkup) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
-	params.Set("message_id", strconv.Itoa(msg))
+	params.Set("chat_id", string(chat))
+	params.Set("message_id", strconv.FormatInt(msg, 10))
 	params.Set("caption", caption)
 	optStr(params, "parse_mode", mode)
 	optBool(params, "disable_Web_page_preview", noPreview)
@@ -106,11 +106,11 @@ func (a *api) EditInlineCaption(msg, caption, mode string, noPreview bool, marku
 //
 // By official documentations, server will return boolean true when editing the message sent by others.
 // This method will report json parse error when such situation.
-func (a *api) EditMarkup(chat string, msg int, markup ReplyMarkup) (*Message, error) {
+func (a *api) EditMarkup(chat ChatID, msg int64, markup ReplyMarkup) (*Message, error) {
 	params := url.Values{}
 
-	params.Set("chat_id", chat)
-	params.Set("message_id", strconv.Itoa(msg))
+	params.Set("chat_id", string(chat))
+	params.Set("message_id", strconv.FormatInt(msg, 10))
 
 	m, err := markup.Bytes()
 	if err != nil {
@@ -138,3 +138,109 @@ func (a *api) EditInlineMarkup(msg string, markup ReplyMarkup) (*Message, error)
 
 	return a.callAndSetMsg("editMessageReplyMarkup", params)
 }
+
+// EditLiveLocation edits live location message, maps to https://core.telegram.org/bots/api#editmessagelivelocation
+//
+// By official documentations, server will return boolean true when editing the message sent by others.
+// This method will report json parse error when such situation.
+func (a *api) EditLiveLocation(chat ChatID, msg int64, lat, lng float64, markup ReplyMarkup) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("message_id", strconv.FormatInt(msg, 10))
+	params.Set("latitude", strconv.FormatFloat(lat, 'f', -1, 64))
+	params.Set("longitude", strconv.FormatFloat(lng, 'f', -1, 64))
+
+	if markup != nil {
+		m, err := markup.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		optJSON(params, "reply_markup", m)
+	}
+
+	return a.callAndSetMsg("editMessageLiveLocation", params)
+}
+
+// EditInlineLiveLocation edits inline live location message, maps to https://core.telegram.org/bots/api#editmessagelivelocation
+//
+// By official documentations, server will return boolean true when editing the message sent by others.
+// This method will report json parse error when such situation.
+func (a *api) EditInlineLiveLocation(msg string, lat, lng float64, markup ReplyMarkup) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("inline_message_id", msg)
+	params.Set("latitude", strconv.FormatFloat(lat, 'f', -1, 64))
+	params.Set("longitude", strconv.FormatFloat(lng, 'f', -1, 64))
+
+	if markup != nil {
+		m, err := markup.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		optJSON(params, "reply_markup", m)
+	}
+
+	return a.callAndSetMsg("editMessageLiveLocation", params)
+}
+
+// StopLiveLocation stops updating live location message, maps to https://core.telegram.org/bots/api#stopmessagelivelocation
+//
+// By official documentations, server will return boolean true when editing the message sent by others.
+// This method will report json parse error when such situation.
+func (a *api) StopLiveLocation(chat ChatID, msg int64, markup ReplyMarkup) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("message_id", strconv.FormatInt(msg, 10))
+
+	if markup != nil {
+		m, err := markup.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		optJSON(params, "reply_markup", m)
+	}
+
+	return a.callAndSetMsg("stopMessageLiveLocation", params)
+}
+
+// StopInlineLiveLocation stops updating inline live location message, maps to https://core.telegram.org/bots/api#stopmessagelivelocation
+//
+// By official documentations, server will return boolean true when editing the message sent by others.
+// This method will report json parse error when such situation.
+func (a *api) StopInlineLiveLocation(msg string, markup ReplyMarkup) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("inline_message_id", msg)
+
+	if markup != nil {
+		m, err := markup.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		optJSON(params, "reply_markup", m)
+	}
+
+	return a.callAndSetMsg("stopMessageLiveLocation", params)
+}
+
+// StopPoll stops a poll which was sent by the bot, maps to https://core.telegram.org/bots/api#stoppoll
+func (a *api) StopPoll(chat ChatID, msg int64, markup ReplyMarkup) (*Poll, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	params.Set("message_id", strconv.FormatInt(msg, 10))
+
+	if markup != nil {
+		m, err := markup.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		optJSON(params, "reply_markup", m)
+	}
+
+	var r pollResult
+	err := a.callAndSet("stopPoll", params, &r)
+	return r.Poll, err
+}
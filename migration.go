@@ -0,0 +1,430 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"io"
+	"sync"
+)
+
+// MigrationHandler is called when a group chat is upgraded to supergroup
+type MigrationHandler func(from, to int64)
+
+// migrateTo returns id of the new chat if err is caused by group migration
+func migrateTo(err error) int64 {
+	e, ok := err.(*ErrNotOK)
+	if !ok {
+		return 0
+	}
+	if p := e.Parameters(); p != nil {
+		return p.MigrateTo
+	}
+	return 0
+}
+
+// FollowMigration wraps an API, following group-to-supergroup migration automatically.
+//
+// When Telegram reports the target group has been migrated, the call is retried against
+// the new supergroup, and cb (if not nil) is called so you can update stored chat ids.
+// Migrated chats are remembered, later calls to the old chat go to the new one directly.
+//
+// Methods editing existing messages are not followed since message ids differ between
+// the group and the supergroup, for the same reason ForwardMessage follows migration of
+// the target chat only. Uploads are redirected to remembered migrations, but
+// not retried as the content has been consumed.
+func FollowMigration(a API, cb MigrationHandler) API {
+	return &migrator{API: a, cb: cb, migrated: map[ChatID]ChatID{}}
+}
+
+type migrator struct {
+	API
+	cb       MigrationHandler
+	lock     sync.RWMutex
+	migrated map[ChatID]ChatID
+}
+
+func (m *migrator) translate(chat ChatID) ChatID {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if to, ok := m.migrated[chat]; ok {
+		return to
+	}
+	return chat
+}
+
+func (m *migrator) retry(chat ChatID, f func(ChatID) error) error {
+	chat = m.translate(chat)
+	err := f(chat)
+	to := migrateTo(err)
+	if to == 0 {
+		return err
+	}
+
+	m.migrate(chat, to)
+	return f(ID(to))
+}
+
+// migrate records the migration and notifies the callback
+func (m *migrator) migrate(chat ChatID, to int64) {
+	m.lock.Lock()
+	m.migrated[chat] = ID(to)
+	m.lock.Unlock()
+	if from, ok := chat.Int64(); ok && m.cb != nil {
+		m.cb(from, to)
+	}
+}
+
+func (m *migrator) ApproveChatJoinRequest(chat ChatID, user int64) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.ApproveChatJoinRequest(c, user)
+	})
+}
+
+func (m *migrator) BanChatMember(chat ChatID, user, until int64, revoke bool) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.BanChatMember(c, user, until, revoke)
+	})
+}
+
+func (m *migrator) BanChatSenderChat(chat ChatID, sender int64) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.BanChatSenderChat(c, sender)
+	})
+}
+
+func (m *migrator) CreateChatInviteLink(chat ChatID, opts *InviteLinkOptions) (ret *ChatInviteLink, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.CreateChatInviteLink(c, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) DeclineChatJoinRequest(chat ChatID, user int64) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.DeclineChatJoinRequest(c, user)
+	})
+}
+
+func (m *migrator) DeleteChatPhoto(chat ChatID) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.DeleteChatPhoto(c)
+	})
+}
+
+func (m *migrator) EditChatInviteLink(chat ChatID, link string, opts *InviteLinkOptions) (ret *ChatInviteLink, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.EditChatInviteLink(c, link, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) ExportChatInviteLink(chat ChatID) (ret string, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.ExportChatInviteLink(c)
+		return
+	})
+	return
+}
+
+func (m *migrator) ForwardMessage(to, from ChatID, silent bool, message int64) (ret *Message, err error) {
+	forward := func(to ChatID) (err error) {
+		ret, err = m.API.ForwardMessage(to, from, silent, message)
+		return
+	}
+
+	// only migration of to is followed, message id means nothing in the new chat of from
+	to = m.translate(to)
+	err = forward(to)
+	id := migrateTo(err)
+	if id == 0 {
+		return
+	}
+
+	// The error does not tell which chat has migrated. If it is from, forwarding to the
+	// new chat fails again with migration error, and the migration is not recorded.
+	if err = forward(ID(id)); migrateTo(err) == 0 {
+		m.migrate(to, id)
+	}
+	return
+}
+
+func (m *migrator) GetChat(chat ChatID) (ret *ChatFullInfo, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.GetChat(c)
+		return
+	})
+	return
+}
+
+func (m *migrator) GetChatAdministrators(chat ChatID) (ret []ChatMember, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.GetChatAdministrators(c)
+		return
+	})
+	return
+}
+
+func (m *migrator) GetChatMember(chat ChatID, user int64) (ret *ChatMember, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.GetChatMember(c, user)
+		return
+	})
+	return
+}
+
+func (m *migrator) GetChatMembersCount(chat ChatID) (ret int, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.GetChatMembersCount(c)
+		return
+	})
+	return
+}
+
+func (m *migrator) KickChatMember(chat ChatID, user int64) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.KickChatMember(c, user)
+	})
+}
+
+func (m *migrator) LeaveChat(chat ChatID) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.LeaveChat(c)
+	})
+}
+
+func (m *migrator) PromoteChatMember(chat ChatID, user int64, rights ChatAdministratorRights) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.PromoteChatMember(c, user, rights)
+	})
+}
+
+func (m *migrator) RestrictChatMember(chat ChatID, user int64, perms ChatPermissions, until int64) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.RestrictChatMember(c, user, perms, until)
+	})
+}
+
+func (m *migrator) RevokeChatInviteLink(chat ChatID, link string) (ret *ChatInviteLink, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.RevokeChatInviteLink(c, link)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendAnimation(chat ChatID, animation string, duration, width, height int, caption string, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendAnimation(c, animation, duration, width, height, caption, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendAudio(chat ChatID, audio string, duration int, performer, title string, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendAudio(c, audio, duration, performer, title, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendChatAction(chat ChatID, action string) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.SendChatAction(c, action)
+	})
+}
+
+func (m *migrator) SendContact(chat ChatID, phone, firstName, lastName string, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendContact(c, phone, firstName, lastName, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendDice(chat ChatID, emoji string, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendDice(c, emoji, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendDocument(chat ChatID, document, caption string, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendDocument(c, document, caption, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendGame(chat ChatID, game string, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendGame(c, game, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendInvoice(chat ChatID, title, desc, payload, provider, currency string, prices []LabeledPrice, invOpts *InvoiceOptions, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendInvoice(c, title, desc, payload, provider, currency, prices, invOpts, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendLiveLocation(chat ChatID, lat, lng float64, livePeriod int, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendLiveLocation(c, lat, lng, livePeriod, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendLocation(chat ChatID, lat, lng float64, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendLocation(c, lat, lng, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendMessage(chat ChatID, text string, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendMessage(c, text, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendPhoto(chat ChatID, photo, caption string, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendPhoto(c, photo, caption, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendPoll(chat ChatID, question string, answers []string, pollOpts *PollOptions, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendPoll(c, question, answers, pollOpts, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendSticker(chat ChatID, sticker, caption string, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendSticker(c, sticker, caption, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendVenue(chat ChatID, lat, lng float64, title, addr, foursq string, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendVenue(c, lat, lng, title, addr, foursq, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendVideo(chat ChatID, video string, duration, width, height int, caption string, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendVideo(c, video, duration, width, height, caption, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendVideoNote(chat ChatID, videoNote string, duration, length int, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendVideoNote(c, videoNote, duration, length, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SendVoice(chat ChatID, voice string, duration int, opts *Options) (ret *Message, err error) {
+	err = m.retry(chat, func(c ChatID) (err error) {
+		ret, err = m.API.SendVoice(c, voice, duration, opts)
+		return
+	})
+	return
+}
+
+func (m *migrator) SetChatAdministratorCustomTitle(chat ChatID, user int64, title string) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.SetChatAdministratorCustomTitle(c, user, title)
+	})
+}
+
+func (m *migrator) SetChatDescription(chat ChatID, desc string) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.SetChatDescription(c, desc)
+	})
+}
+
+func (m *migrator) SetChatPermissions(chat ChatID, perms ChatPermissions) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.SetChatPermissions(c, perms)
+	})
+}
+
+func (m *migrator) SetChatPhoto(chat ChatID, photo io.Reader) error {
+	return m.API.SetChatPhoto(m.translate(chat), photo)
+}
+
+func (m *migrator) SetChatTitle(chat ChatID, title string) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.SetChatTitle(c, title)
+	})
+}
+
+func (m *migrator) UnbanChatMember(chat ChatID, user int64) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.UnbanChatMember(c, user)
+	})
+}
+
+func (m *migrator) UnbanChatSenderChat(chat ChatID, sender int64) error {
+	return m.retry(chat, func(c ChatID) error {
+		return m.API.UnbanChatSenderChat(c, sender)
+	})
+}
+
+func (m *migrator) UploadAnimation(chat ChatID, animation io.Reader, duration, width, height int, caption string, opts *Options) (*Message, error) {
+	return m.API.UploadAnimation(m.translate(chat), animation, duration, width, height, caption, opts)
+}
+
+func (m *migrator) UploadAudio(chat ChatID, audio io.Reader, duration int, performer, title string, opts *Options) (*Message, error) {
+	return m.API.UploadAudio(m.translate(chat), audio, duration, performer, title, opts)
+}
+
+func (m *migrator) UploadDocument(chat ChatID, document io.Reader, caption string, opts *Options) (*Message, error) {
+	return m.API.UploadDocument(m.translate(chat), document, caption, opts)
+}
+
+func (m *migrator) UploadPhoto(chat ChatID, photo io.Reader, caption string, opts *Options) (*Message, error) {
+	return m.API.UploadPhoto(m.translate(chat), photo, caption, opts)
+}
+
+func (m *migrator) UploadSticker(chat ChatID, sticker io.Reader, caption string, opts *Options) (*Message, error) {
+	return m.API.UploadSticker(m.translate(chat), sticker, caption, opts)
+}
+
+func (m *migrator) UploadVideo(chat ChatID, video io.Reader, duration, width, height int, caption string, opts *Options) (*Message, error) {
+	return m.API.UploadVideo(m.translate(chat), video, duration, width, height, caption, opts)
+}
+
+func (m *migrator) UploadVideoNote(chat ChatID, videoNote io.Reader, duration, length int, opts *Options) (*Message, error) {
+	return m.API.UploadVideoNote(m.translate(chat), videoNote, duration, length, opts)
+}
+
+func (m *migrator) UploadVoice(chat ChatID, voice io.Reader, duration int, opts *Options) (*Message, error) {
+	return m.API.UploadVoice(m.translate(chat), voice, duration, opts)
+}
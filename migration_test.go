@@ -0,0 +1,95 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"reflect"
+	"testing"
+)
+
+type migratedGroup struct {
+	API
+	sent []ChatID
+}
+
+func (g *migratedGroup) SendMessage(chat ChatID, text string, opts *Options) (*Message, error) {
+	g.sent = append(g.sent, chat)
+	if chat == "-1" {
+		return nil, &ErrNotOK{
+			Method: "sendMessage",
+			Bytes:  []byte(`{"ok":false,"error_code":400,"parameters":{"migrate_to_chat_id":-1001}}`),
+		}
+	}
+	return &Message{}, nil
+}
+
+func (g *migratedGroup) ForwardMessage(to, from ChatID, silent bool, message int64) (*Message, error) {
+	g.sent = append(g.sent, to+">"+from)
+	if to == "-1" || from == "-1" {
+		return nil, &ErrNotOK{
+			Method: "forwardMessage",
+			Bytes:  []byte(`{"ok":false,"error_code":400,"parameters":{"migrate_to_chat_id":-1001}}`),
+		}
+	}
+	return &Message{}, nil
+}
+
+func TestFollowMigration(t *testing.T) {
+	g := &migratedGroup{API: Fake(nil)}
+	var from, to int64
+	a := FollowMigration(g, func(f, t int64) {
+		from, to = f, t
+	})
+
+	if _, err := a.SendMessage("-1", "hello", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if from != -1 || to != -1001 {
+		t.Errorf("expected migration from -1 to -1001, got %d to %d", from, to)
+	}
+
+	if _, err := a.SendMessage("-1", "hello again", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expect := []ChatID{"-1", "-1001", "-1001"}
+	if len(g.sent) != len(expect) {
+		t.Fatalf("expected calls to %v, got %v", expect, g.sent)
+	}
+	for i, c := range expect {
+		if g.sent[i] != c {
+			t.Errorf("expected calls to %v, got %v", expect, g.sent)
+		}
+	}
+}
+
+func TestFollowMigrationForward(t *testing.T) {
+	tbl := []struct {
+		to, from ChatID
+		migrated int64
+		expect   []ChatID
+	}{
+		{"-1", "2", -1, []ChatID{"-1>2", "-1001>2", "-1001>2"}},
+		{"2", "-1", 0, []ChatID{"2>-1", "-1001>-1", "2>-1", "-1001>-1"}},
+	}
+
+	for _, data := range tbl {
+		g := &migratedGroup{API: Fake(nil)}
+		var from int64
+		a := FollowMigration(g, func(f, t int64) { from = f })
+
+		_, err := a.ForwardMessage(data.to, data.from, false, 1)
+		if (err == nil) != (data.migrated != 0) {
+			t.Errorf("%s>%s: unexpected error: %v", data.to, data.from, err)
+		}
+		if from != data.migrated {
+			t.Errorf("%s>%s: expected migration from %d, got %d", data.to, data.from, data.migrated, from)
+		}
+
+		// second call shows whether the migration is remembered
+		a.ForwardMessage(data.to, data.from, false, 1)
+		if !reflect.DeepEqual(g.sent, data.expect) {
+			t.Errorf("%s>%s: expected calls %v, got %v", data.to, data.from, data.expect, g.sent)
+		}
+	}
+}
@@ -9,17 +9,17 @@ import (
 )
 
 // GetMe maps to https://core.telegram.org/bots/api#getme
-func (a *api) GetMe() (*Victim, error) {
+func (a *api) GetMe() (*User, error) {
 	var v userResult
 	err := a.callAndSet("getMe", url.Values{}, &v)
 	return v.User, err
 }
 
 // GetUserProfilePhotos maps to https://core.telegram.org/bots/api#getuserprofilephotos
-func (a *api) GetUserProfilePhotos(user, offset, limit int) (*UserProfilePhotos, error) {
+func (a *api) GetUserProfilePhotos(user int64, offset, limit int) (*UserProfilePhotos, error) {
 	params := url.Values{}
 
-	params.Set("user_id", strconv.Itoa(user))
+	params.Set("user_id", strconv.FormatInt(user, 10))
 	optInt(params, "offset", offset)
 	optInt(params, "limit", limit)
 
@@ -39,13 +39,24 @@ func (a *api) GetFile(file string) (*File, error) {
 	return r.File, err
 }
 
+// CallbackQueryOptions represents optional parameters for api method answerCallbackQuery
+type CallbackQueryOptions struct {
+	URL       string // game URL for callback query from game button, or t.me link to open the bot with a parameter
+	CacheTime int    // in seconds
+}
+
 // AnswerCallbackQuery maps to https://core.telegram.org/bots/api#answercallbackquery
-func (a *api) AnswerCallbackQuery(query, text string, alert bool) error {
+func (a *api) AnswerCallbackQuery(query, text string, alert bool, opts *CallbackQueryOptions) error {
 	params := url.Values{}
 
 	params.Set("callback_query_id", query)
 	optStr(params, "text", text)
 	optBool(params, "show_alert", alert)
 
+	if opts != nil {
+		optStr(params, "url", opts.URL)
+		optInt(params, "cache_time", opts.CacheTime)
+	}
+
 	return a.callAndSet("answerCallbackQuery", params, nil)
 }
@@ -0,0 +1,148 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// InvoiceOptions represents optional parameters for api method sendInvoice and createInvoiceLink
+type InvoiceOptions struct {
+	MaxTipAmount              int    `json:"max_tip_amount,omitempty"`
+	SuggestedTipAmounts       []int  `json:"suggested_tip_amounts,omitempty"`
+	StartParameter            string `json:"-"`                       // sendInvoice only
+	ProviderData              string `json:"provider_data,omitempty"` // JSON-serialized data for payment provider
+	PhotoURL                  string `json:"photo_url,omitempty"`
+	PhotoSize                 int    `json:"photo_size,omitempty"`
+	PhotoWidth                int    `json:"photo_width,omitempty"`
+	PhotoHeight               int    `json:"photo_height,omitempty"`
+	NeedName                  bool   `json:"need_name,omitempty"`
+	NeedPhoneNumber           bool   `json:"need_phone_number,omitempty"`
+	NeedEmail                 bool   `json:"need_email,omitempty"`
+	NeedShippingAddress       bool   `json:"need_shipping_address,omitempty"`
+	SendPhoneNumberToProvider bool   `json:"send_phone_number_to_provider,omitempty"`
+	SendEmailToProvider       bool   `json:"send_email_to_provider,omitempty"`
+	Flexible                  bool   `json:"is_flexible,omitempty"` // final price depends on the shipping method
+}
+
+// invoiceContent is the JSON form of invoice, shared by InvoiceContent and setInvoice
+type invoiceContent struct {
+	Title         string         `json:"title"`
+	Description   string         `json:"description"`
+	Payload       string         `json:"payload"`
+	ProviderToken string         `json:"provider_token"`
+	Currency      string         `json:"currency"`
+	Prices        []LabeledPrice `json:"prices"`
+	*InvoiceOptions
+}
+
+// setInvoice sets invoice parameters shared by sendInvoice and createInvoiceLink
+//
+// Parameters are derived from the JSON form of the invoice, strings are set as is,
+// other values as JSON.
+func setInvoice(params url.Values, title, desc, payload, provider, currency string, prices []LabeledPrice, opts *InvoiceOptions) error {
+	buf, err := json.Marshal(invoiceContent{
+		Title:          title,
+		Description:    desc,
+		Payload:        payload,
+		ProviderToken:  provider,
+		Currency:       currency,
+		Prices:         prices,
+		InvoiceOptions: opts,
+	})
+	if err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(buf, &fields); err != nil {
+		return err
+	}
+
+	for key, val := range fields {
+		var str string
+		if json.Unmarshal(val, &str) == nil {
+			params.Set(key, str)
+			continue
+		}
+		params.Set(key, string(val))
+	}
+
+	return nil
+}
+
+// SendInvoice maps to https://core.telegram.org/bots/api#sendinvoice
+func (a *api) SendInvoice(chat ChatID, title, desc, payload, provider, currency string, prices []LabeledPrice, invOpts *InvoiceOptions, opts *Options) (*Message, error) {
+	params := url.Values{}
+
+	params.Set("chat_id", string(chat))
+	if err := setInvoice(params, title, desc, payload, provider, currency, prices, invOpts); err != nil {
+		return nil, err
+	}
+	if invOpts != nil {
+		optStr(params, "start_parameter", invOpts.StartParameter)
+	}
+
+	if opts != nil {
+		optBool(params, "disable_notification", opts.Silent)
+		optInt64(params, "reply_to_message_id", opts.ReplyID)
+
+		if markup := opts.ReplyMarkup; markup != nil {
+			m, err := markup.Bytes()
+			if err != nil {
+				return nil, err
+			}
+			optJSON(params, "reply_markup", m)
+		}
+	}
+
+	return a.callAndSetMsg("sendInvoice", params)
+}
+
+// CreateInvoiceLink creates a link for an invoice, maps to https://core.telegram.org/bots/api#createinvoicelink
+func (a *api) CreateInvoiceLink(title, desc, payload, provider, currency string, prices []LabeledPrice, invOpts *InvoiceOptions) (string, error) {
+	params := url.Values{}
+
+	if err := setInvoice(params, title, desc, payload, provider, currency, prices, invOpts); err != nil {
+		return "", err
+	}
+
+	var r strResult
+	err := a.callAndSet("createInvoiceLink", params, &r)
+	return r.Result, err
+}
+
+// AnswerShippingQuery maps to https://core.telegram.org/bots/api#answershippingquery
+//
+// Pass available shipping options if ok, or human readable error message if not.
+func (a *api) AnswerShippingQuery(query string, ok bool, options []ShippingOption, errMsg string) error {
+	params := url.Values{}
+
+	params.Set("shipping_query_id", query)
+	params.Set("ok", strconv.FormatBool(ok))
+	if ok {
+		buf, err := json.Marshal(options)
+		if err != nil {
+			return err
+		}
+		params.Set("shipping_options", string(buf))
+	}
+	optStr(params, "error_message", errMsg)
+
+	return a.callAndSet("answerShippingQuery", params, nil)
+}
+
+// AnswerPreCheckoutQuery maps to https://core.telegram.org/bots/api#answerprecheckoutquery
+//
+// Pass human readable error message if not ok.
+func (a *api) AnswerPreCheckoutQuery(query string, ok bool, errMsg string) error {
+	params := url.Values{}
+
+	params.Set("pre_checkout_query_id", query)
+	params.Set("ok", strconv.FormatBool(ok))
+	optStr(params, "error_message", errMsg)
+
+	return a.callAndSet("answerPreCheckoutQuery", params, nil)
+}
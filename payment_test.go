@@ -0,0 +1,34 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestSetInvoice(t *testing.T) {
+	params := url.Values{}
+	err := setInvoice(params, "t", "d", "p", "token", "USD", []LabeledPrice{LabeledPrice{"item", 100}}, &InvoiceOptions{
+		NeedEmail:           true,
+		SuggestedTipAmounts: []int{10, 20},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expect := url.Values{
+		"title":                 []string{"t"},
+		"description":           []string{"d"},
+		"payload":               []string{"p"},
+		"provider_token":        []string{"token"},
+		"currency":              []string{"USD"},
+		"prices":                []string{`[{"label":"item","amount":100}]`},
+		"need_email":            []string{"true"},
+		"suggested_tip_amounts": []string{"[10,20]"},
+	}
+	if params.Encode() != expect.Encode() {
+		t.Errorf("expected params %s, got %s", expect.Encode(), params.Encode())
+	}
+}
@@ -0,0 +1,286 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MessageHandler handles incoming message
+type MessageHandler func(msg *Message)
+
+// InlineQueryHandler handles incoming inline query, see InlinePager.Handle
+type InlineQueryHandler func(q *InlineQuery)
+
+// UsersSharedHandler handles users shared by KeyboardButtonRequestUsers
+type UsersSharedHandler func(m *Message, shared *UsersShared)
+
+// ChatSharedHandler handles chat shared by KeyboardButtonRequestChat
+type ChatSharedHandler func(m *Message, shared *ChatShared)
+
+// CallbackQueryHandler handles incoming callback query, see Menu.Handle
+type CallbackQueryHandler func(q *CallbackQuery)
+
+// Router dispatches updates to registered handlers.
+//
+// Zero value is ready to use. Updates without matching handler are dropped silently.
+// Router can be used as webhook handler directly, or fed by LongPollFetcher.Update.
+type Router struct {
+	lock      sync.RWMutex
+	commands  map[string]MessageHandler
+	descs     map[string]string
+	message   MessageHandler
+	migration MigrationHandler
+	inline    InlineQueryHandler
+	users     UsersSharedHandler
+	chat      ChatSharedHandler
+	callbacks map[string]CallbackQueryHandler
+	answerer  *callbackAnswerer
+	answerErr func(q *CallbackQuery, err error)
+	deadline  time.Duration
+	afterFunc func(time.Duration, func()) // time.AfterFunc if nil, replaced in tests
+}
+
+// HandleMessage registers handler for messages not handled by other handlers
+func (r *Router) HandleMessage(h MessageHandler) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.message = h
+}
+
+// HandleCommand registers handler for bot command, cmd should not contain leading slash.
+//
+// Both "/cmd" and "/cmd@your_bot" are dispatched to the handler.
+// Commands with non-empty desc are listed in Commands.
+func (r *Router) HandleCommand(cmd, desc string, h MessageHandler) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.commands == nil {
+		r.commands = map[string]MessageHandler{}
+		r.descs = map[string]string{}
+	}
+	r.commands[cmd] = h
+	r.descs[cmd] = desc
+}
+
+// Commands lists registered commands with description, sorted by name.
+//
+// Pass the result to API.SetMyCommands to build the command menu.
+func (r *Router) Commands() []BotCommand {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	ret := []BotCommand{}
+	for cmd, desc := range r.descs {
+		if desc != "" {
+			ret = append(ret, BotCommand{cmd, desc})
+		}
+	}
+	sort.Sort(byCommand(ret))
+
+	return ret
+}
+
+type byCommand []BotCommand
+
+func (b byCommand) Len() int           { return len(b) }
+func (b byCommand) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byCommand) Less(i, j int) bool { return b[i].Command < b[j].Command }
+
+// HandleMigration registers handler for group-to-supergroup migration service messages.
+//
+// Telegram sends service messages to both the group and the supergroup, so the handler
+// might be called twice for one migration.
+func (r *Router) HandleMigration(h MigrationHandler) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.migration = h
+}
+
+// HandleUsersShared registers handler for users_shared service messages
+func (r *Router) HandleUsersShared(h UsersSharedHandler) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.users = h
+}
+
+// HandleChatShared registers handler for chat_shared service messages
+func (r *Router) HandleChatShared(h ChatSharedHandler) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.chat = h
+}
+
+// HandleInlineQuery registers handler for inline queries
+func (r *Router) HandleInlineQuery(h InlineQueryHandler) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.inline = h
+}
+
+// HandleCallbackQuery registers handler for callback queries with data prefixed by "name:".
+//
+// Callback queries matching no name are dispatched to the handler registered with empty name.
+func (r *Router) HandleCallbackQuery(name string, h CallbackQueryHandler) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.callbacks == nil {
+		r.callbacks = map[string]CallbackQueryHandler{}
+	}
+	r.callbacks[name] = h
+}
+
+// DefaultAnswerDeadline is the deadline of Router.AutoAnswer if not specified
+const DefaultAnswerDeadline = 5 * time.Second
+
+// AutoAnswer makes sure every callback query is answered, so the client stops showing progress bar.
+//
+// Callback queries not answered through returned API within deadline are answered with empty answer
+// through a, so are those the handler failed to answer. Handlers must use the returned API to answer
+// callback queries, or they are answered twice. Errors of empty answers are passed to the handler
+// registered by HandleAnswerError.
+//
+// Non-positive deadline defaults to DefaultAnswerDeadline.
+func (r *Router) AutoAnswer(a API, deadline time.Duration) API {
+	if deadline <= 0 {
+		deadline = DefaultAnswerDeadline
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.answerer = &callbackAnswerer{API: a, pending: map[string]bool{}}
+	r.deadline = deadline
+	return r.answerer
+}
+
+// HandleAnswerError registers handler for errors answering callback queries on behalf of handlers, see AutoAnswer
+func (r *Router) HandleAnswerError(h func(q *CallbackQuery, err error)) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.answerErr = h
+}
+
+// callbackAnswerer tracks callback queries not answered yet
+type callbackAnswerer struct {
+	API
+	lock    sync.Mutex
+	pending map[string]bool
+}
+
+func (c *callbackAnswerer) add(query string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.pending[query] = true
+}
+
+// take removes the query from pending list, returns false if it is answered
+func (c *callbackAnswerer) take(query string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	ret := c.pending[query]
+	delete(c.pending, query)
+	return ret
+}
+
+// AnswerCallbackQuery leaves the query pending if failed, so it is still answered after deadline
+func (c *callbackAnswerer) AnswerCallbackQuery(query, text string, alert bool, opts *CallbackQueryOptions) error {
+	if err := c.API.AnswerCallbackQuery(query, text, alert, opts); err != nil {
+		return err
+	}
+	c.take(query)
+	return nil
+}
+
+// Dispatch sends the update to matching handler
+func (r *Router) Dispatch(u *Update) {
+	switch {
+	case u.Message != nil:
+		r.dispatchMessage(u.Message)
+	case u.InlineQuery != nil:
+		r.lock.RLock()
+		h := r.inline
+		r.lock.RUnlock()
+		if h != nil {
+			h(u.InlineQuery)
+		}
+	case u.CallbackQuery != nil:
+		r.dispatchCallbackQuery(u.CallbackQuery)
+	}
+}
+
+func (r *Router) dispatchCallbackQuery(q *CallbackQuery) {
+	name := q.Data
+	if idx := strings.IndexByte(name, ':'); idx >= 0 {
+		name = name[:idx]
+	}
+
+	r.lock.RLock()
+	h, ok := r.callbacks[name]
+	if !ok {
+		h = r.callbacks[""]
+	}
+	answerer, answerErr, deadline, afterFunc := r.answerer, r.answerErr, r.deadline, r.afterFunc
+	r.lock.RUnlock()
+
+	if answerer != nil {
+		if afterFunc == nil {
+			afterFunc = func(d time.Duration, f func()) { time.AfterFunc(d, f) }
+		}
+		answerer.add(q.ID)
+		afterFunc(deadline, func() {
+			if !answerer.take(q.ID) {
+				return
+			}
+			if err := answerer.API.AnswerCallbackQuery(q.ID, "", false, nil); err != nil && answerErr != nil {
+				answerErr(q, err)
+			}
+		})
+	}
+
+	if h != nil {
+		h(q)
+	}
+}
+
+func (r *Router) dispatchMessage(m *Message) {
+	r.lock.RLock()
+	migration, users, chat := r.migration, r.users, r.chat
+	h, ok := r.commands[m.Command()]
+	if !ok {
+		h = r.message
+	}
+	r.lock.RUnlock()
+
+	switch {
+	case m.MigrateTo != 0:
+		if migration != nil {
+			migration(m.Chat.ID, m.MigrateTo)
+		}
+	case m.MigrateFrom != 0:
+		if migration != nil {
+			migration(m.MigrateFrom, m.Chat.ID)
+		}
+	case m.UsersShared != nil:
+		if users != nil {
+			users(m, m.UsersShared)
+		}
+	case m.ChatShared != nil:
+		if chat != nil {
+			chat(m, m.ChatShared)
+		}
+	case h != nil:
+		h(m)
+	}
+}
+
+// ServeHTTP implements http.Handler, so Router can be used as webhook handler
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	WebhookHandler(func(w http.ResponseWriter, req *http.Request, u *Update) {
+		r.Dispatch(u)
+	}).ServeHTTP(w, req)
+}
@@ -0,0 +1,141 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRouterDispatch(t *testing.T) {
+	var r Router
+	var got string
+	r.HandleMessage(func(m *Message) { got = "message" })
+	r.HandleCommand("start", "", func(m *Message) { got = "start" })
+	r.HandleMigration(func(from, to int64) { got = "migration" })
+	r.HandleUsersShared(func(m *Message, s *UsersShared) { got = "users" })
+	r.HandleChatShared(func(m *Message, s *ChatShared) { got = "chat" })
+
+	cmd := func(text string, length int) *Message {
+		return &Message{
+			Chat:     &Chat{ID: 1},
+			Text:     text,
+			Entities: []MessageEntity{MessageEntity{Type: BotCommandEntity, Length: length}},
+		}
+	}
+
+	tbl := []struct {
+		msg    *Message
+		expect string
+	}{
+		{&Message{Chat: &Chat{ID: 1}, Text: "hi"}, "message"},
+		{cmd("/start", 6), "start"},
+		{cmd("/start@my_bot now", 13), "start"},
+		{cmd("/help", 5), "message"},
+		{cmd("/start", 0), "message"},
+		{cmd("/start", 7), "message"},
+		{&Message{Chat: &Chat{ID: -1}, MigrateTo: -1001}, "migration"},
+		{&Message{Chat: &Chat{ID: -1001}, MigrateFrom: -1}, "migration"},
+		{&Message{Chat: &Chat{ID: 1}, UsersShared: &UsersShared{RequestID: 1}}, "users"},
+		{&Message{Chat: &Chat{ID: 1}, ChatShared: &ChatShared{RequestID: 2, ID: -1001}}, "chat"},
+	}
+
+	for _, data := range tbl {
+		got = ""
+		r.Dispatch(&Update{Message: data.msg})
+		if got != data.expect {
+			t.Errorf("message %#v should be handled by %s handler, got %s", data.msg.Text, data.expect, got)
+		}
+	}
+}
+
+func TestRouterCommands(t *testing.T) {
+	var r Router
+	h := func(m *Message) {}
+	r.HandleCommand("start", "", h)
+	r.HandleCommand("stop", "stop notifications", h)
+	r.HandleCommand("help", "show help", h)
+
+	expect := []BotCommand{
+		BotCommand{"help", "show help"},
+		BotCommand{"stop", "stop notifications"},
+	}
+	if actual := r.Commands(); !reflect.DeepEqual(actual, expect) {
+		t.Errorf("expected commands %v, got %v", expect, actual)
+	}
+}
+
+func TestRouterCallbackQuery(t *testing.T) {
+	var r Router
+	var got string
+	r.HandleCallbackQuery("", func(q *CallbackQuery) { got = "default" })
+	r.HandleCallbackQuery("menu", func(q *CallbackQuery) { got = "menu" })
+
+	tbl := []struct {
+		data   string
+		expect string
+	}{
+		{"menu::1", "menu"},
+		{"menu", "menu"},
+		{"menus:1", "default"},
+		{"", "default"},
+	}
+
+	for _, data := range tbl {
+		got = ""
+		r.Dispatch(&Update{CallbackQuery: &CallbackQuery{Data: data.data}})
+		if got != data.expect {
+			t.Errorf("callback data %s should be handled by %s handler, got %s", data.data, data.expect, got)
+		}
+	}
+}
+
+type answerRecorder struct {
+	API
+	answers []string
+}
+
+func (a *answerRecorder) AnswerCallbackQuery(query, text string, alert bool, opts *CallbackQueryOptions) error {
+	a.answers = append(a.answers, query+":"+text)
+	if text == "fail" || query == "4" {
+		return errors.New("cannot answer " + query)
+	}
+	return nil
+}
+
+func TestRouterAutoAnswer(t *testing.T) {
+	var r Router
+	var timers []func()
+	r.afterFunc = func(d time.Duration, f func()) {
+		if d != DefaultAnswerDeadline {
+			t.Errorf("expected default deadline, got %s", d)
+		}
+		timers = append(timers, f)
+	}
+	rec := &answerRecorder{API: Fake(nil)}
+	a := r.AutoAnswer(rec, 0)
+	r.HandleCallbackQuery("answer", func(q *CallbackQuery) { a.AnswerCallbackQuery(q.ID, "ok", false, nil) })
+	r.HandleCallbackQuery("forget", func(q *CallbackQuery) {})
+	r.HandleCallbackQuery("fail", func(q *CallbackQuery) { a.AnswerCallbackQuery(q.ID, "fail", false, nil) })
+	var failed []string
+	r.HandleAnswerError(func(q *CallbackQuery, err error) { failed = append(failed, q.ID) })
+
+	r.Dispatch(&Update{CallbackQuery: &CallbackQuery{ID: "1", Data: "answer"}})
+	r.Dispatch(&Update{CallbackQuery: &CallbackQuery{ID: "2", Data: "forget"}})
+	r.Dispatch(&Update{CallbackQuery: &CallbackQuery{ID: "3", Data: "fail"}})
+	r.Dispatch(&Update{CallbackQuery: &CallbackQuery{ID: "4", Data: "forget"}})
+	for _, f := range timers {
+		f()
+	}
+
+	expect := []string{"1:ok", "3:fail", "2:", "3:", "4:"}
+	if !reflect.DeepEqual(rec.answers, expect) {
+		t.Errorf("expected answers %v, got %v", expect, rec.answers)
+	}
+	if !reflect.DeepEqual(failed, []string{"4"}) {
+		t.Errorf("expected failed answer of 4, got %v", failed)
+	}
+}
@@ -16,7 +16,17 @@ type LongPollFetcher struct {
 	EditedMessage      chan *Message
 	InlineQuery        chan *InlineQuery
 	ChosenInlineResult chan *ChosenInlineResult
+	ChannelPost        chan *Message
+	EditedChannelPost  chan *Message
 	CallbackQuery      chan *CallbackQuery
+	ShippingQuery      chan *ShippingQuery
+	PreCheckoutQuery   chan *PreCheckoutQuery
+	Poll               chan *Poll
+	PollAnswer         chan *PollAnswer
+	MyChatMember       chan *ChatMemberUpdated
+	ChatMember         chan *ChatMemberUpdated
+	ChatJoinRequest    chan *ChatJoinRequest
+	Update             chan *Update // receives updates not sent to other channels, see Router
 	API                API
 }
 
@@ -26,24 +36,65 @@ func (b byUpdateID) Len() int           { return len(b) }
 func (b byUpdateID) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
 func (b byUpdateID) Less(i, j int) bool { return b[i].ID < b[j].ID }
 
+// AllowedUpdates computes allowed update types from non-nil channels.
+//
+// All types are allowed if LongPollFetcher.Update is not nil. It returns nil if no channel
+// is set, so the setting of previous GetUpdates call is kept.
+func (l *LongPollFetcher) AllowedUpdates() []string {
+	if l.Update != nil {
+		return append([]string{}, AllUpdates...)
+	}
+
+	var ret []string
+	for _, c := range []struct {
+		typ     string
+		enabled bool
+	}{
+		{UpdateMessage, l.Message != nil},
+		{UpdateEditedMessage, l.EditedMessage != nil},
+		{UpdateChannelPost, l.ChannelPost != nil},
+		{UpdateEditedChannelPost, l.EditedChannelPost != nil},
+		{UpdateInlineQuery, l.InlineQuery != nil},
+		{UpdateChosenInlineResult, l.ChosenInlineResult != nil},
+		{UpdateCallbackQuery, l.CallbackQuery != nil},
+		{UpdateShippingQuery, l.ShippingQuery != nil},
+		{UpdatePreCheckoutQuery, l.PreCheckoutQuery != nil},
+		{UpdatePoll, l.Poll != nil},
+		{UpdatePollAnswer, l.PollAnswer != nil},
+		{UpdateMyChatMember, l.MyChatMember != nil},
+		{UpdateChatMember, l.ChatMember != nil},
+		{UpdateChatJoinRequest, l.ChatJoinRequest != nil},
+	} {
+		if c.enabled {
+			ret = append(ret, c.typ)
+		}
+	}
+
+	return ret
+}
+
 // Fetch fetches messages by long polling in loops
+//
+// Only updates with non-nil channel are requested, see AllowedUpdates.
 func (l *LongPollFetcher) Fetch(limit, timeout int) error {
-	offset := 0
+	var offset int64
 	if limit < 1 {
 		limit = 1
 	}
 	if timeout < 0 {
 		timeout = 0
 	}
+	allowed := l.AllowedUpdates()
 	for {
-		data, err := l.API.GetUpdates(offset, limit, timeout)
+		data, err := l.API.GetUpdates(offset, limit, timeout, allowed)
 		if err != nil {
 			return err
 		}
 
 		sort.Sort(byUpdateID(data))
 
-		for _, u := range data {
+		for idx := range data {
+			u := &data[idx]
 			if u.ID >= offset {
 				offset = u.ID + 1
 			}
@@ -56,8 +107,28 @@ func (l *LongPollFetcher) Fetch(limit, timeout int) error {
 				l.InlineQuery <- u.InlineQuery
 			case u.ChosenInlineResult != nil && l.ChosenInlineResult != nil:
 				l.ChosenInlineResult <- u.ChosenInlineResult
+			case u.ChannelPost != nil && l.ChannelPost != nil:
+				l.ChannelPost <- u.ChannelPost
+			case u.EditedChannelPost != nil && l.EditedChannelPost != nil:
+				l.EditedChannelPost <- u.EditedChannelPost
 			case u.CallbackQuery != nil && l.CallbackQuery != nil:
 				l.CallbackQuery <- u.CallbackQuery
+			case u.ShippingQuery != nil && l.ShippingQuery != nil:
+				l.ShippingQuery <- u.ShippingQuery
+			case u.PreCheckoutQuery != nil && l.PreCheckoutQuery != nil:
+				l.PreCheckoutQuery <- u.PreCheckoutQuery
+			case u.Poll != nil && l.Poll != nil:
+				l.Poll <- u.Poll
+			case u.PollAnswer != nil && l.PollAnswer != nil:
+				l.PollAnswer <- u.PollAnswer
+			case u.MyChatMember != nil && l.MyChatMember != nil:
+				l.MyChatMember <- u.MyChatMember
+			case u.ChatMember != nil && l.ChatMember != nil:
+				l.ChatMember <- u.ChatMember
+			case u.ChatJoinRequest != nil && l.ChatJoinRequest != nil:
+				l.ChatJoinRequest <- u.ChatJoinRequest
+			case l.Update != nil:
+				l.Update <- u
 			}
 		}
 	}
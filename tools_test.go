@@ -0,0 +1,37 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAllowedUpdates(t *testing.T) {
+	tbl := []struct {
+		fetcher *LongPollFetcher
+		expect  []string
+	}{
+		{&LongPollFetcher{}, nil},
+		{
+			&LongPollFetcher{Message: make(chan *Message), CallbackQuery: make(chan *CallbackQuery)},
+			[]string{UpdateMessage, UpdateCallbackQuery},
+		},
+		{&LongPollFetcher{Message: make(chan *Message), Update: make(chan *Update)}, AllUpdates},
+	}
+
+	for _, data := range tbl {
+		if actual := data.fetcher.AllowedUpdates(); !reflect.DeepEqual(actual, data.expect) {
+			t.Errorf("expected allowed updates %v, got %v", data.expect, actual)
+		}
+	}
+}
+
+func TestAllowedUpdatesCopy(t *testing.T) {
+	l := &LongPollFetcher{Update: make(chan *Update)}
+	l.AllowedUpdates()[0] = "modified"
+	if AllUpdates[0] != UpdateMessage {
+		t.Errorf("AllUpdates is modified through AllowedUpdates: %v", AllUpdates)
+	}
+}
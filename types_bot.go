@@ -0,0 +1,68 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import "encoding/json"
+
+// BotCommand represents a bot command.
+type BotCommand struct {
+	Command     string `json:"command"` // 1-32 characters, lowercase letters, digits and underscores
+	Description string `json:"description"`
+}
+
+// these are valid bot command scope types
+const (
+	ScopeDefault               = "default"
+	ScopeAllPrivateChats       = "all_private_chats"
+	ScopeAllGroupChats         = "all_group_chats"
+	ScopeAllChatAdministrators = "all_chat_administrators"
+	ScopeChat                  = "chat"
+	ScopeChatAdministrators    = "chat_administrators"
+	ScopeChatMember            = "chat_member"
+)
+
+// BotCommandScope represents the scope to which bot commands are applied.
+//
+// Chat is required for ScopeChat, ScopeChatAdministrators and ScopeChatMember, User is required for ScopeChatMember.
+type BotCommandScope struct {
+	Type string
+	Chat ChatID
+	User int64
+}
+
+// MarshalJSON implements json.Marshaler, sends numeric chat id as number
+func (s BotCommandScope) MarshalJSON() ([]byte, error) {
+	ret := map[string]interface{}{"type": s.Type}
+	if id, ok := s.Chat.Int64(); ok {
+		ret["chat_id"] = id
+	} else if s.Chat != "" {
+		ret["chat_id"] = string(s.Chat)
+	}
+	if s.User != 0 {
+		ret["user_id"] = s.User
+	}
+
+	return json.Marshal(ret)
+}
+
+// WebAppInfo describes a Web App.
+type WebAppInfo struct {
+	URL string `json:"url"`
+}
+
+// these are valid menu button types
+const (
+	MenuButtonDefault  = "default"
+	MenuButtonCommands = "commands"
+	MenuButtonWebApp   = "web_app"
+)
+
+// MenuButton describes the bot's menu button in a private chat.
+//
+// Text and WebApp are required only for MenuButtonWebApp.
+type MenuButton struct {
+	Type   string      `json:"type"`
+	Text   string      `json:"text,omitempty"`
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
+}
@@ -0,0 +1,26 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+// Game represents a game.
+type Game struct {
+	Title        string          `json:"title"`
+	Description  string          `json:"description"`
+	Photo        []PhotoSize     `json:"photo"`
+	Text         string          `json:"text,omitempty"`
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+	Animation    *Animation      `json:"animation,omitempty"`
+}
+
+// CallbackGame is a placeholder, use it in InlineKeyboardButton to launch the game.
+//
+// Answer the resulting callback query with game URL in CallbackQueryOptions.URL to open the game.
+type CallbackGame struct{}
+
+// GameHighScore represents one row of the high scores table for a game.
+type GameHighScore struct {
+	Position int   `json:"position"`
+	User     *User `json:"user"`
+	Score    int   `json:"score"`
+}
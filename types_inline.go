@@ -3,19 +3,25 @@
 
 package telegram
 
+import "encoding/json"
+
 // InlineQuery represents an incoming inline query.
 // When the user sends an empty query, your bot could return some default or trending results.
 type InlineQuery struct {
 	ID       string    `json:"id"`
-	From     *Victim   `json:"from"`
+	From     *User     `json:"from"`
 	Location *Location `json:"location,omitempty"`
 	Query    string    `json:"query"`
 	Offset   string    `json:"offset"`
 }
 
 // InlineQueryResult holds methods for all kinds of inline query results
+//
+// Results linking to files stored on the Telegram servers (InlineQueryResultCachedPhoto and others)
+// share the same type with their URL counterparts, they are told apart by the file id field.
 type InlineQueryResult interface {
-	ValidateType() // validates the result type
+	ValidateType()   // validates the result type
+	Validate() error // validates required fields and length limits, returns *InvalidResultError
 }
 
 // IQR holds common fields for inline query results
@@ -43,14 +49,23 @@ func (r *InlineQueryResultArticle) ValidateType() {
 	r.IQR.Type = "article"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultArticle) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("title", r.Title),
+		r.requiredContent(),
+	)
+}
+
 // InlineQueryResultPhoto represents a link to a photo.
 type InlineQueryResultPhoto struct {
 	IQR
-	URL     string `json:"photo_url,omitempty"`
-	FileID  string `json:"photo_file_id,omitempty"`
-	Width   int    `json:"photo_width,omitempty"`
-	Height  int    `json:"photo_height,omitempty"`
-	Caption string `json:"caption,omitempty"`
+	URL       string `json:"photo_url"`
+	Width     int    `json:"photo_width,omitempty"`
+	Height    int    `json:"photo_height,omitempty"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
 }
 
 // ValidateType validate and rewrite result type
@@ -58,14 +73,24 @@ func (r *InlineQueryResultPhoto) ValidateType() {
 	r.IQR.Type = "photo"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultPhoto) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("photo_url", r.URL),
+		r.required("thumb_url", r.ThumbURL),
+		r.caption(r.Caption),
+	)
+}
+
 // InlineQueryResultGif represents a link to an animated GIF file.
 type InlineQueryResultGif struct {
 	IQR
-	URL     string `json:"gif_url,omitempty"`
-	FileID  string `json:"gif_file_id,omitempty"`
-	Width   int    `json:"git_width,omitempty"`
-	Height  int    `json:"gif_height,omitempty"`
-	Caption string `json:"caption,omitempty"`
+	URL       string `json:"gif_url"`
+	Width     int    `json:"gif_width,omitempty"`
+	Height    int    `json:"gif_height,omitempty"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
 }
 
 // ValidateType validate and rewrite result type
@@ -73,14 +98,24 @@ func (r *InlineQueryResultGif) ValidateType() {
 	r.IQR.Type = "gif"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultGif) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("gif_url", r.URL),
+		r.required("thumb_url", r.ThumbURL),
+		r.caption(r.Caption),
+	)
+}
+
 // InlineQueryResultMpeg4Gif represents a link to a video animation.
 type InlineQueryResultMpeg4Gif struct {
 	IQR
-	URL     string `json:"mpeg4_url,omitempty"`
-	FileID  string `json:"mpeg4_file_id,omitempty"`
-	Width   int    `json:"mpeg4_width,omitempty"`
-	Height  int    `json:"mpeg4_height,omitempty"`
-	Caption string `json:"caption,omitempty"`
+	URL       string `json:"mpeg4_url"`
+	Width     int    `json:"mpeg4_width,omitempty"`
+	Height    int    `json:"mpeg4_height,omitempty"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
 }
 
 // ValidateType validate and rewrite result type
@@ -88,15 +123,25 @@ func (r *InlineQueryResultMpeg4Gif) ValidateType() {
 	r.IQR.Type = "mpeg4_gif"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultMpeg4Gif) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("mpeg4_url", r.URL),
+		r.required("thumb_url", r.ThumbURL),
+		r.caption(r.Caption),
+	)
+}
+
 // InlineQueryResultVideo represnets a link to a page containing an embedded video player or a video file.
 type InlineQueryResultVideo struct {
 	IQR
-	URL      string `json:"video_url,omitempty"`
-	FileID   string `json:"video_file_id,omitempty"`
-	MimeType string `json:"mime_type"`
-	Caption  string `json:"caption,omitempty"`
-	Width    int    `json:"video_width,omitempty"`
-	Height   int    `json:"video_height,omitempty"`
+	URL       string `json:"video_url"`
+	MimeType  string `json:"mime_type"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
+	Width     int    `json:"video_width,omitempty"`
+	Height    int    `json:"video_height,omitempty"`
 }
 
 // ValidateType validate and rewrite result type
@@ -104,11 +149,24 @@ func (r *InlineQueryResultVideo) ValidateType() {
 	r.IQR.Type = "video"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultVideo) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("video_url", r.URL),
+		r.required("mime_type", r.MimeType),
+		r.required("thumb_url", r.ThumbURL),
+		r.required("title", r.Title),
+		r.caption(r.Caption),
+	)
+}
+
 // InlineQueryResultAudio represents a link to an mp3 audio file.
 type InlineQueryResultAudio struct {
 	IQR
-	URL       string `json:"audio_url,omitempty"`
-	FileID    string `json:"audio_file_id,omitempty"`
+	URL       string `json:"audio_url"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
 	Performer string `json:"performer,omitempty"`
 	Duration  int    `json:"audio_duration,omitempty"`
 }
@@ -118,12 +176,23 @@ func (r *InlineQueryResultAudio) ValidateType() {
 	r.IQR.Type = "audio"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultAudio) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("audio_url", r.URL),
+		r.required("title", r.Title),
+		r.caption(r.Caption),
+	)
+}
+
 // InlineQueryResultVoice represents a link to a voice recording in an .off container encoded with OPUS.
 type InlineQueryResultVoice struct {
 	IQR
-	URL      string `json:"voice_url,omitempty"`
-	FileID   string `json:"voice_file_id,omitempty"`
-	Duration int    `json:"voice_duration,omitempty"`
+	URL       string `json:"voice_url"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
+	Duration  int    `json:"voice_duration,omitempty"`
 }
 
 // ValidateType validate and rewrite result type
@@ -131,13 +200,23 @@ func (r *InlineQueryResultVoice) ValidateType() {
 	r.IQR.Type = "voice"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultVoice) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("voice_url", r.URL),
+		r.required("title", r.Title),
+		r.caption(r.Caption),
+	)
+}
+
 // InlineQueryResultDocument represents a link to a file.
 type InlineQueryResultDocument struct {
 	IQR
-	Caption  string `json:"caption,omitempty"`
-	URL      string `json:"document_url,omitempty"`
-	FileID   string `json:"document_file_id,omitempty"`
-	MimeType string `json:"mime_type"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
+	URL       string `json:"document_url"`
+	MimeType  string `json:"mime_type"`
 }
 
 // ValidateType validate and rewrite result type
@@ -145,6 +224,17 @@ func (r *InlineQueryResultDocument) ValidateType() {
 	r.IQR.Type = "document"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultDocument) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("document_url", r.URL),
+		r.required("mime_type", r.MimeType),
+		r.required("title", r.Title),
+		r.caption(r.Caption),
+	)
+}
+
 // InlineQueryResultLocation represents a location on a map.
 type InlineQueryResultLocation struct {
 	IQR
@@ -157,6 +247,14 @@ func (r *InlineQueryResultLocation) ValidateType() {
 	r.IQR.Type = "location"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultLocation) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("title", r.Title),
+	)
+}
+
 // InlineQueryResultVenue represents a venue.
 type InlineQueryResultVenue struct {
 	IQR
@@ -171,6 +269,15 @@ func (r *InlineQueryResultVenue) ValidateType() {
 	r.IQR.Type = "venue"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultVenue) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("title", r.Title),
+		r.required("address", r.Address),
+	)
+}
+
 // InlineQueryResultContact represents a contact with a phone number.
 type InlineQueryResultContact struct {
 	IQR
@@ -184,8 +291,90 @@ func (r *InlineQueryResultContact) ValidateType() {
 	r.IQR.Type = "contact"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultContact) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("phone_number", r.PhoneNumber),
+		r.required("first_name", r.FirstName),
+	)
+}
+
+// InlineQueryResultCachedPhoto represents a link to a photo stored on the Telegram servers.
+//
+// This struct maps to https://core.telegram.org/bots/api#inlinequeryresultcachedphoto
+type InlineQueryResultCachedPhoto struct {
+	IQR
+	FileID    string `json:"photo_file_id"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
+}
+
+// ValidateType validate and rewrite result type
+func (r *InlineQueryResultCachedPhoto) ValidateType() {
+	r.IQR.Type = "photo"
+}
+
+// Validate checks required fields and length limits
+func (r *InlineQueryResultCachedPhoto) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("photo_file_id", r.FileID),
+		r.caption(r.Caption),
+	)
+}
+
+// InlineQueryResultCachedGif represents a link to an animated GIF file stored on the Telegram servers.
+//
+// This struct maps to https://core.telegram.org/bots/api#inlinequeryresultcachedgif
+type InlineQueryResultCachedGif struct {
+	IQR
+	FileID    string `json:"gif_file_id"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
+}
+
+// ValidateType validate and rewrite result type
+func (r *InlineQueryResultCachedGif) ValidateType() {
+	r.IQR.Type = "gif"
+}
+
+// Validate checks required fields and length limits
+func (r *InlineQueryResultCachedGif) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("gif_file_id", r.FileID),
+		r.caption(r.Caption),
+	)
+}
+
+// InlineQueryResultCachedMpeg4Gif represents a link to a video animation stored on the Telegram servers.
+//
+// This struct maps to https://core.telegram.org/bots/api#inlinequeryresultcachedmpeg4gif
+type InlineQueryResultCachedMpeg4Gif struct {
+	IQR
+	FileID    string `json:"mpeg4_file_id"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
+}
+
+// ValidateType validate and rewrite result type
+func (r *InlineQueryResultCachedMpeg4Gif) ValidateType() {
+	r.IQR.Type = "mpeg4_gif"
+}
+
+// Validate checks required fields and length limits
+func (r *InlineQueryResultCachedMpeg4Gif) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("mpeg4_file_id", r.FileID),
+		r.caption(r.Caption),
+	)
+}
+
 // InlineQueryResultSticker represents a link to a sticker stored on the Telegram servers.
 //
+// Stickers have only the cached form, so there is no InlineQueryResultCachedSticker.
 // This struct maps to https://core.telegram.org/bots/api#inlinequeryresultcachedsticker
 type InlineQueryResultSticker struct {
 	IQR
@@ -197,65 +386,264 @@ func (r *InlineQueryResultSticker) ValidateType() {
 	r.IQR.Type = "sticker"
 }
 
+// Validate checks required fields and length limits
+func (r *InlineQueryResultSticker) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("sticker_file_id", r.FileID),
+	)
+}
+
+// InlineQueryResultCachedDocument represents a link to a file stored on the Telegram servers.
+//
+// This struct maps to https://core.telegram.org/bots/api#inlinequeryresultcacheddocument
+type InlineQueryResultCachedDocument struct {
+	IQR
+	FileID    string `json:"document_file_id"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
+}
+
+// ValidateType validate and rewrite result type
+func (r *InlineQueryResultCachedDocument) ValidateType() {
+	r.IQR.Type = "document"
+}
+
+// Validate checks required fields and length limits
+func (r *InlineQueryResultCachedDocument) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("document_file_id", r.FileID),
+		r.required("title", r.Title),
+		r.caption(r.Caption),
+	)
+}
+
+// InlineQueryResultCachedVideo represents a link to a video file stored on the Telegram servers.
+//
+// This struct maps to https://core.telegram.org/bots/api#inlinequeryresultcachedvideo
+type InlineQueryResultCachedVideo struct {
+	IQR
+	FileID    string `json:"video_file_id"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
+}
+
+// ValidateType validate and rewrite result type
+func (r *InlineQueryResultCachedVideo) ValidateType() {
+	r.IQR.Type = "video"
+}
+
+// Validate checks required fields and length limits
+func (r *InlineQueryResultCachedVideo) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("video_file_id", r.FileID),
+		r.required("title", r.Title),
+		r.caption(r.Caption),
+	)
+}
+
+// InlineQueryResultCachedVoice represents a link to a voice message stored on the Telegram servers.
+//
+// This struct maps to https://core.telegram.org/bots/api#inlinequeryresultcachedvoice
+type InlineQueryResultCachedVoice struct {
+	IQR
+	FileID    string `json:"voice_file_id"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
+}
+
+// ValidateType validate and rewrite result type
+func (r *InlineQueryResultCachedVoice) ValidateType() {
+	r.IQR.Type = "voice"
+}
+
+// Validate checks required fields and length limits
+func (r *InlineQueryResultCachedVoice) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("voice_file_id", r.FileID),
+		r.required("title", r.Title),
+		r.caption(r.Caption),
+	)
+}
+
+// InlineQueryResultCachedAudio represents a link to an mp3 audio file stored on the Telegram servers.
+//
+// This struct maps to https://core.telegram.org/bots/api#inlinequeryresultcachedaudio
+type InlineQueryResultCachedAudio struct {
+	IQR
+	FileID    string `json:"audio_file_id"`
+	Caption   string `json:"caption,omitempty"`
+	ParseMode string `json:"parse_mode,omitempty"`
+}
+
+// ValidateType validate and rewrite result type
+func (r *InlineQueryResultCachedAudio) ValidateType() {
+	r.IQR.Type = "audio"
+}
+
+// Validate checks required fields and length limits
+func (r *InlineQueryResultCachedAudio) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("audio_file_id", r.FileID),
+		r.caption(r.Caption),
+	)
+}
+
+// InlineQueryResultGame represents a Game.
+type InlineQueryResultGame struct {
+	IQR
+	GameShortName string `json:"game_short_name"`
+}
+
+// ValidateType validate and rewrite result type
+func (r *InlineQueryResultGame) ValidateType() {
+	r.IQR.Type = "game"
+}
+
+// Validate checks required fields and length limits
+func (r *InlineQueryResultGame) Validate() error {
+	return firstError(
+		r.IQR.validate(),
+		r.required("game_short_name", r.GameShortName),
+	)
+}
+
 // InputMessageContent represents the content of a message to be sent as an result of an inline query.
-type InputMessageContent map[string]interface{}
+//
+// Implemented by TextContent, LocationContent, VenueContent, ContactContent and InvoiceContent.
+type InputMessageContent interface {
+	inputMessageContent()
+}
+
+// TextContent represents the content of a text message
+type TextContent struct {
+	Text        string              `json:"message_text"`
+	ParseMode   string              `json:"parse_mode,omitempty"`
+	Entities    []MessageEntity     `json:"entities,omitempty"`
+	LinkPreview *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+}
+
+func (c *TextContent) inputMessageContent() {}
+
+// LocationContent represents the content of a location message
+type LocationContent struct {
+	Lat                  float64 `json:"latitude"`
+	Lng                  float64 `json:"longitude"`
+	HorizontalAccuracy   float64 `json:"horizontal_accuracy,omitempty"`
+	LivePeriod           int     `json:"live_period,omitempty"`
+	Heading              int     `json:"heading,omitempty"`
+	ProximityAlertRadius int     `json:"proximity_alert_radius,omitempty"`
+}
+
+func (c *LocationContent) inputMessageContent() {}
+
+// VenueContent represents the content of a venue message
+type VenueContent struct {
+	Lat             float64 `json:"latitude"`
+	Lng             float64 `json:"longitude"`
+	Title           string  `json:"title"`
+	Address         string  `json:"address"`
+	Foursquare      string  `json:"foursquare_id,omitempty"`
+	FoursquareType  string  `json:"foursquare_type,omitempty"`
+	GooglePlaceID   string  `json:"google_place_id,omitempty"`
+	GooglePlaceType string  `json:"google_place_type,omitempty"`
+}
+
+func (c *VenueContent) inputMessageContent() {}
+
+// ContactContent represents the content of a contact message
+type ContactContent struct {
+	PhoneNumber string `json:"phone_number"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name,omitempty"`
+	VCard       string `json:"vcard,omitempty"`
+}
+
+func (c *ContactContent) inputMessageContent() {}
+
+// InvoiceContent represents the content of an invoice message
+type InvoiceContent struct {
+	Title         string
+	Description   string
+	Payload       string
+	ProviderToken string
+	Currency      string
+	Prices        []LabeledPrice
+	Options       *InvoiceOptions // InvoiceOptions.StartParameter is ignored
+}
+
+func (c *InvoiceContent) inputMessageContent() {}
+
+// MarshalJSON implements json.Marshaler
+func (c *InvoiceContent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(invoiceContent{
+		Title:          c.Title,
+		Description:    c.Description,
+		Payload:        c.Payload,
+		ProviderToken:  c.ProviderToken,
+		Currency:       c.Currency,
+		Prices:         c.Prices,
+		InvoiceOptions: c.Options,
+	})
+}
 
 // InputTextMessageContent creates an InputMessageContent for text message
 func InputTextMessageContent(msg, mode string, noPreview bool) InputMessageContent {
-	ret := map[string]interface{}{"message_text": msg}
+	ret := &TextContent{Text: msg, ParseMode: mode}
 
-	if mode != "" {
-		ret["parse_mode"] = mode
-	}
 	if noPreview {
-		ret["disable_web_page_preview"] = true
+		ret.LinkPreview = &LinkPreviewOptions{Disabled: true}
 	}
 
-	return InputMessageContent(ret)
+	return ret
 }
 
 // InputLocationMessageContent creates an InputMessageContent for location message
 func InputLocationMessageContent(lat, lng float64) InputMessageContent {
-	return InputMessageContent(map[string]interface{}{
-		"latitude":  lat,
-		"longitude": lng,
-	})
+	return &LocationContent{Lat: lat, Lng: lng}
 }
 
 // InputVenueMessageContent creates an InputMessageContent for venue message
 func InputVenueMessageContent(lat, lng float64, title, addr, foursq string) InputMessageContent {
-	ret := map[string]interface{}{
-		"latitude":  lat,
-		"longitude": lng,
-		"title":     title,
-		"address":   addr,
-	}
-
-	if foursq != "" {
-		ret["foursquare_id"] = foursq
+	return &VenueContent{
+		Lat:        lat,
+		Lng:        lng,
+		Title:      title,
+		Address:    addr,
+		Foursquare: foursq,
 	}
-
-	return InputMessageContent(ret)
 }
 
 // InputContactMessageContent creates an InputMessageContent for contact message
 func InputContactMessageContent(phone, firstName, lastName string) InputMessageContent {
-	ret := map[string]interface{}{
-		"phone_number": phone,
-		"first_name":   firstName,
+	return &ContactContent{
+		PhoneNumber: phone,
+		FirstName:   firstName,
+		LastName:    lastName,
 	}
+}
 
-	if lastName != "" {
-		ret["last_name"] = lastName
+// InputInvoiceMessageContent creates an InputMessageContent for invoice message
+func InputInvoiceMessageContent(title, desc, payload, provider, currency string, prices []LabeledPrice, opts *InvoiceOptions) InputMessageContent {
+	return &InvoiceContent{
+		Title:         title,
+		Description:   desc,
+		Payload:       payload,
+		ProviderToken: provider,
+		Currency:      currency,
+		Prices:        prices,
+		Options:       opts,
 	}
-
-	return InputMessageContent(ret)
 }
 
 // ChosenInlineResult represents a result of an inline query that was chosen by the user and sent to their chat partner.
 type ChosenInlineResult struct {
 	ID       string    `json:"result_id"`
-	From     *Victim   `json:"from"`
+	From     *User     `json:"from"`
 	Location *Location `json:"location,omitempty"`
 	InlineID string    `json:"inline_message_id,omitempty"`
 	Query    string    `json:"query,omitempty"`
@@ -0,0 +1,104 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestInputMessageContent(t *testing.T) {
+	tbl := []struct {
+		content InputMessageContent
+		expect  string
+	}{
+		{
+			InputTextMessageContent("hi", MarkdownMode, true),
+			`{"message_text":"hi","parse_mode":"Markdown","link_preview_options":{"is_disabled":true}}`,
+		},
+		{
+			&LocationContent{Lat: 25.1, Lng: 121.5, LivePeriod: 60},
+			`{"latitude":25.1,"longitude":121.5,"live_period":60}`,
+		},
+		{
+			InputVenueMessageContent(25.1, 121.5, "title", "addr", ""),
+			`{"latitude":25.1,"longitude":121.5,"title":"title","address":"addr"}`,
+		},
+		{
+			&ContactContent{PhoneNumber: "123", FirstName: "first", VCard: "BEGIN:VCARD"},
+			`{"phone_number":"123","first_name":"first","vcard":"BEGIN:VCARD"}`,
+		},
+		{
+			InputInvoiceMessageContent("t", "d", "p", "token", "USD", []LabeledPrice{LabeledPrice{"item", 100}}, &InvoiceOptions{StartParameter: "ignored", NeedEmail: true}),
+			`{"title":"t","description":"d","payload":"p","provider_token":"token","currency":"USD","prices":[{"label":"item","amount":100}],"need_email":true}`,
+		},
+	}
+
+	for _, data := range tbl {
+		buf, err := json.Marshal(data.content)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if string(buf) != data.expect {
+			t.Errorf("expected %s, got %s", data.expect, string(buf))
+		}
+	}
+}
+
+// TestInlineQueryResultFixtures catches typos in struct tags, fixtures are written after Bot API document.
+//
+// input_message_content is decoded into the TextContent set in advance, as json cannot
+// decode into an empty interface.
+func TestInlineQueryResultFixtures(t *testing.T) {
+	tbl := map[string]InlineQueryResult{
+		"article":          &InlineQueryResultArticle{IQR: IQR{InputMessageContent: &TextContent{}}},
+		"photo":            &InlineQueryResultPhoto{},
+		"gif":              &InlineQueryResultGif{},
+		"mpeg4_gif":        &InlineQueryResultMpeg4Gif{},
+		"video":            &InlineQueryResultVideo{},
+		"audio":            &InlineQueryResultAudio{},
+		"voice":            &InlineQueryResultVoice{},
+		"document":         &InlineQueryResultDocument{},
+		"location":         &InlineQueryResultLocation{},
+		"venue":            &InlineQueryResultVenue{},
+		"contact":          &InlineQueryResultContact{},
+		"game":             &InlineQueryResultGame{},
+		"cached_photo":     &InlineQueryResultCachedPhoto{},
+		"cached_gif":       &InlineQueryResultCachedGif{},
+		"cached_mpeg4_gif": &InlineQueryResultCachedMpeg4Gif{},
+		"cached_sticker":   &InlineQueryResultSticker{},
+		"cached_document":  &InlineQueryResultCachedDocument{},
+		"cached_video":     &InlineQueryResultCachedVideo{},
+		"cached_voice":     &InlineQueryResultCachedVoice{},
+		"cached_audio":     &InlineQueryResultCachedAudio{},
+	}
+
+	for name, result := range tbl {
+		fixture, err := ioutil.ReadFile(filepath.Join("testdata", "inline_results", name+".json"))
+		if err != nil {
+			t.Fatalf("cannot read fixture of %s: %s", name, err)
+		}
+		if err = json.Unmarshal(fixture, result); err != nil {
+			t.Fatalf("cannot decode fixture of %s: %s", name, err)
+		}
+
+		if err = result.Validate(); err != nil {
+			t.Errorf("%s: fixture should be valid, got %s", name, err)
+		}
+		buf, err := json.Marshal(result)
+		if err != nil {
+			t.Fatalf("cannot encode %s: %s", name, err)
+		}
+
+		var expect, actual interface{}
+		json.Unmarshal(fixture, &expect)
+		json.Unmarshal(buf, &actual)
+		if !reflect.DeepEqual(expect, actual) {
+			t.Errorf("%s: expected %s, got %s", name, string(fixture), string(buf))
+		}
+	}
+}
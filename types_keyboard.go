@@ -12,10 +12,12 @@ type ReplyMarkup interface {
 
 // ReplyKeyboardMarkup represents a custom keyboard with reply options.
 type ReplyKeyboardMarkup struct {
-	Keyboard  [][]KeyboardButton `json:"keyboard"`
-	Resize    bool               `json:"resize_keyboard,omitempty"`
-	Once      bool               `json:"one_time_keyboard,omitempty"`
-	Selective bool               `json:"selective,omitempty"`
+	Keyboard    [][]KeyboardButton `json:"keyboard"`
+	Persistent  bool               `json:"is_persistent,omitempty"`
+	Resize      bool               `json:"resize_keyboard,omitempty"`
+	Once        bool               `json:"one_time_keyboard,omitempty"`
+	Placeholder string             `json:"input_field_placeholder,omitempty"` // 1-64 characters
+	Selective   bool               `json:"selective,omitempty"`
 }
 
 // Bytes serializes the structure into JSON format
@@ -27,9 +29,48 @@ func (m *ReplyKeyboardMarkup) Bytes() ([]byte, error) {
 // For simple text buttons String can be used instead of this object to specify text of the button.
 // Optional fields are mutually exclusive.
 type KeyboardButton struct {
-	Text     string `json:"text"`
-	Contact  bool   `json:"request_contact,omitempty"`
-	Location bool   `json:"request_location,omitempty"`
+	Text     string                      `json:"text"`
+	Users    *KeyboardButtonRequestUsers `json:"request_users,omitempty"`
+	Chat     *KeyboardButtonRequestChat  `json:"request_chat,omitempty"`
+	Contact  bool                        `json:"request_contact,omitempty"`
+	Location bool                        `json:"request_location,omitempty"`
+	Poll     *KeyboardButtonPollType     `json:"request_poll,omitempty"`
+	WebApp   *WebAppInfo                 `json:"web_app,omitempty"`
+}
+
+// KeyboardButtonPollType represents type of a poll, which is allowed to be created and sent when the corresponding button is pressed.
+type KeyboardButtonPollType struct {
+	Type string `json:"type,omitempty"` // RegularPoll or QuizPoll, any type is allowed if empty
+}
+
+// KeyboardButtonRequestUsers defines the criteria used to request suitable users.
+//
+// Identifiers of selected users will be shared with the bot in Message.UsersShared.
+type KeyboardButtonRequestUsers struct {
+	RequestID       int   `json:"request_id"` // must be unique within the message
+	IsBot           *bool `json:"user_is_bot,omitempty"`
+	IsPremium       *bool `json:"user_is_premium,omitempty"`
+	MaxQuantity     int   `json:"max_quantity,omitempty"` // 1-10, defaults to 1
+	RequestName     bool  `json:"request_name,omitempty"`
+	RequestUsername bool  `json:"request_username,omitempty"`
+	RequestPhoto    bool  `json:"request_photo,omitempty"`
+}
+
+// KeyboardButtonRequestChat defines the criteria used to request a suitable chat.
+//
+// Identifier of selected chat will be shared with the bot in Message.ChatShared.
+type KeyboardButtonRequestChat struct {
+	RequestID       int                      `json:"request_id"` // must be unique within the message
+	IsChannel       bool                     `json:"chat_is_channel"`
+	IsForum         *bool                    `json:"chat_is_forum,omitempty"`
+	HasUsername     *bool                    `json:"chat_has_username,omitempty"`
+	IsCreated       bool                     `json:"chat_is_created,omitempty"`
+	UserRights      *ChatAdministratorRights `json:"user_administrator_rights,omitempty"`
+	BotRights       *ChatAdministratorRights `json:"bot_administrator_rights,omitempty"`
+	BotIsMember     bool                     `json:"bot_is_member,omitempty"`
+	RequestTitle    bool                     `json:"request_title,omitempty"`
+	RequestUsername bool                     `json:"request_username,omitempty"`
+	RequestPhoto    bool                     `json:"request_photo,omitempty"`
 }
 
 // FormatKeyboard reformats keyboard buttons
@@ -62,15 +103,29 @@ func FormatKeyboard(buttons []KeyboardButton, ncol int) [][]KeyboardButton {
 	return ret
 }
 
+// ReplyKeyboardRemove will remove the current custom keyboard and display the default letter-keyboard.
+type ReplyKeyboardRemove struct {
+	Remove    bool `json:"remove_keyboard"` // always sent as true
+	Selective bool `json:"selective,omitempty"`
+}
+
+// Bytes serializes the structure into JSON format
+func (r *ReplyKeyboardRemove) Bytes() ([]byte, error) {
+	return json.Marshal(ReplyKeyboardRemove{Remove: true, Selective: r.Selective})
+}
+
 // ReplyKeyboardHide will hide the current custom keyboard and display the default letter-keyboard.
+//
+// Deprecated: Telegram has replaced hide_keyboard with remove_keyboard, use ReplyKeyboardRemove instead.
+// It is serialized as ReplyKeyboardRemove for compatibility.
 type ReplyKeyboardHide struct {
-	Hide      bool `json:"hide_keyboard,omitempty"`
+	Hide      bool `json:"hide_keyboard,omitempty"` // ignored, the keyboard is always removed
 	Selective bool `json:"selective,omitempty"`
 }
 
-// Bytes serializes the structure into JSON format
+// Bytes serializes the structure into JSON format of ReplyKeyboardRemove
 func (h *ReplyKeyboardHide) Bytes() ([]byte, error) {
-	return json.Marshal(h)
+	return (&ReplyKeyboardRemove{Selective: h.Selective}).Bytes()
 }
 
 // InlineKeyboardMarkup represents an inline keyboard that appears right next to the message it belongs to.
@@ -86,10 +141,29 @@ func (m *InlineKeyboardMarkup) Bytes() ([]byte, error) {
 // InlineKeyboardButton represents one button of an inline keyboard.
 // You must use exactly one of the optional fields.
 type InlineKeyboardButton struct {
-	Text   string `json:"text"`
-	URL    string `json:"url,omitempty"`
-	Data   string `json:"callback_data,omitempty"`
-	Switch string `json:"switch_inline_query,omitempty"`
+	Text              string          `json:"text"`
+	URL               string          `json:"url,omitempty"`
+	Data              string          `json:"callback_data,omitempty"`                    // 1-64 bytes
+	Switch            *string         `json:"switch_inline_query,omitempty"`              // pointer so empty query can be sent, see SwitchButton
+	SwitchCurrentChat *string         `json:"switch_inline_query_current_chat,omitempty"` // pointer so empty query can be sent, see SwitchCurrentChatButton
+	LoginURL          *LoginURL       `json:"login_url,omitempty"`
+	WebApp            *WebAppInfo     `json:"web_app,omitempty"`
+	Game              *CallbackGame   `json:"callback_game,omitempty"` // must be the first button in the first row
+	Pay               bool            `json:"pay,omitempty"`           // must be the first button in the first row
+	CopyText          *CopyTextButton `json:"copy_text,omitempty"`
+}
+
+// LoginURL represents a parameter of the inline keyboard button used to automatically authorize a user.
+type LoginURL struct {
+	URL         string `json:"url"`
+	ForwardText string `json:"forward_text,omitempty"`
+	BotUsername string `json:"bot_username,omitempty"`
+	WriteAccess bool   `json:"request_write_access,omitempty"`
+}
+
+// CopyTextButton represents an inline keyboard button that copies specified text to the clipboard.
+type CopyTextButton struct {
+	Text string `json:"text"`
 }
 
 // FormatInlineKeyboard reformats keyboard buttons
@@ -124,8 +198,9 @@ func FormatInlineKeyboard(buttons []InlineKeyboardButton, ncol int) [][]InlineKe
 
 // ForceReply will display a reply interface to the user (act as if the user has selected the bot‘s message and tapped ’Reply').
 type ForceReply struct {
-	Reply     bool `json:"force_reply,omitempty"`
-	Selective bool `json:"selective,omitempty"`
+	Reply       bool   `json:"force_reply,omitempty"`
+	Placeholder string `json:"input_field_placeholder,omitempty"` // 1-64 characters
+	Selective   bool   `json:"selective,omitempty"`
 }
 
 // Bytes serializes the structure into JSON format
@@ -135,9 +210,11 @@ func (r *ForceReply) Bytes() ([]byte, error) {
 
 // CallbackQuery represents an incoming callback query from a callback button in an inline keyboard.
 type CallbackQuery struct {
-	ID       string   `json:"id"`
-	From     *Victim  `json:"from"`
-	Message  *Message `json:"message,omitempty"`
-	InlineID string   `json:"inline_message_id,omitempty"`
-	Data     string   `json:"data,omitempty"`
+	ID            string   `json:"id"`
+	From          *User    `json:"from"`
+	Message       *Message `json:"message,omitempty"`
+	InlineID      string   `json:"inline_message_id,omitempty"`
+	ChatInstance  string   `json:"chat_instance,omitempty"`
+	Data          string   `json:"data,omitempty"`
+	GameShortName string   `json:"game_short_name,omitempty"`
 }
@@ -171,3 +171,52 @@ func TestFormatInlineKeyboard(t *testing.T) {
 		}
 	}
 }
+
+func TestReplyMarkupBytes(t *testing.T) {
+	tbl := []struct {
+		markup ReplyMarkup
+		expect string
+	}{
+		{
+			&ReplyKeyboardRemove{},
+			`{"remove_keyboard":true}`,
+		},
+		{
+			&ReplyKeyboardHide{Selective: true},
+			`{"remove_keyboard":true,"selective":true}`,
+		},
+		{
+			&ReplyKeyboardHide{Hide: true, Selective: true},
+			`{"remove_keyboard":true,"selective":true}`,
+		},
+		{
+			&ForceReply{Reply: true, Placeholder: "reply"},
+			`{"force_reply":true,"input_field_placeholder":"reply"}`,
+		},
+		{
+			&ReplyKeyboardMarkup{
+				Keyboard: [][]KeyboardButton{[]KeyboardButton{
+					KeyboardButton{Text: "poll", Poll: &KeyboardButtonPollType{Type: QuizPoll}},
+					KeyboardButton{Text: "users", Users: &KeyboardButtonRequestUsers{RequestID: 1, MaxQuantity: 3}},
+					KeyboardButton{Text: "chat", Chat: &KeyboardButtonRequestChat{RequestID: 2}},
+				}},
+				Persistent:  true,
+				Placeholder: "pick one",
+			},
+			`{"keyboard":[[{"text":"poll","request_poll":{"type":"quiz"}},` +
+				`{"text":"users","request_users":{"request_id":1,"max_quantity":3}},` +
+				`{"text":"chat","request_chat":{"request_id":2,"chat_is_channel":false}}]],` +
+				`"is_persistent":true,"input_field_placeholder":"pick one"}`,
+		},
+	}
+
+	for _, data := range tbl {
+		buf, err := data.markup.Bytes()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if string(buf) != data.expect {
+			t.Errorf("expected %s, got %s", data.expect, string(buf))
+		}
+	}
+}
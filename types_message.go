@@ -3,40 +3,80 @@
 
 package telegram
 
+import "strings"
+
 // Message represents a message
 type Message struct {
-	ID                    int             `json:"message_id"`
-	From                  *Victim         `json:"from,omitempty"`
-	Timestamp             int64           `json:"date"`
-	Chat                  *Victim         `json:"chat"`
-	ForwardFrom           *Victim         `json:"forward_from,omitempty"`
-	ForwardChat           *Victim         `json:"forward_from_chat,omitempty"`
-	ForwardTimestamp      int64           `json:"forward_date,omitempty"`
-	ReplyTo               *Message        `json:"reply_to_message,omitempty"`
-	EditTimestamp         int64           `json:"edit_date,omitempty"`
-	Text                  string          `json:"text,omitempty"`
-	Entities              []MessageEntity `json:"entities,omitempty"`
-	Audio                 *Audio          `json:"audio,omitempty"`
-	Document              *Document       `json:"document,omitempty"`
-	Photo                 []PhotoSize     `json:"photo,omitempty"`
-	Sticker               *Sticker        `json:"sticker,omitempty"`
-	Video                 *Video          `json:"video,omitempty"`
-	Voice                 *Voice          `json:"voice,omitempty"`
-	Caption               string          `json:"caption,omitempty"`
-	Contact               *Contact        `json:"contact,omitempty"`
-	Location              *Location       `json:"location,omitempty"`
-	Venue                 *Venue          `json:"venue,omitempty"`
-	NewChatMember         *Victim         `json:"new_chat_member,omitempty"`
-	LeftChatMember        *Victim         `json:"left_chat_member,omitempty"`
-	NewChatTitle          string          `json:"new_chat_title,omitempty"`
-	NewChatPhoto          []PhotoSize     `json:"new_chat_photo,omitempty"`
-	DeleteChatPhoto       bool            `json:"delete_chat_photo,omitempty"`
-	GroupChatCreated      bool            `json:"group_chat_created,omitempty"`
-	SuperGroupChatCreated bool            `json:"supergroup_chat_created,omitempty"`
-	ChannelChatCreated    bool            `json:"channel_chat_created,omitempty"`
-	MigrateTo             int64           `json:"migrate_to_chat_id,omitempty"`
-	MigrateFrom           int64           `json:"migrate_from_chat_id,omitempty"`
-	Pinned                *Message        `json:"pinned_message,omitempty"`
+	ID                    int64              `json:"message_id"`
+	From                  *User              `json:"from,omitempty"`
+	SenderChat            *Chat              `json:"sender_chat,omitempty"` // sent on behalf of a chat
+	Timestamp             int64              `json:"date"`
+	Chat                  *Chat              `json:"chat"`
+	ForwardFrom           *User              `json:"forward_from,omitempty"`
+	ForwardChat           *Chat              `json:"forward_from_chat,omitempty"`
+	ForwardTimestamp      int64              `json:"forward_date,omitempty"`
+	ReplyTo               *Message           `json:"reply_to_message,omitempty"`
+	EditTimestamp         int64              `json:"edit_date,omitempty"`
+	Text                  string             `json:"text,omitempty"`
+	Entities              []MessageEntity    `json:"entities,omitempty"`
+	Animation             *Animation         `json:"animation,omitempty"`
+	Audio                 *Audio             `json:"audio,omitempty"`
+	Document              *Document          `json:"document,omitempty"`
+	Game                  *Game              `json:"game,omitempty"`
+	Photo                 []PhotoSize        `json:"photo,omitempty"`
+	Sticker               *Sticker           `json:"sticker,omitempty"`
+	Video                 *Video             `json:"video,omitempty"`
+	Voice                 *Voice             `json:"voice,omitempty"`
+	VideoNote             *VideoNote         `json:"video_note,omitempty"`
+	Caption               string             `json:"caption,omitempty"`
+	Contact               *Contact           `json:"contact,omitempty"`
+	Dice                  *Dice              `json:"dice,omitempty"`
+	Poll                  *Poll              `json:"poll,omitempty"`
+	Location              *Location          `json:"location,omitempty"`
+	Venue                 *Venue             `json:"venue,omitempty"`
+	NewChatMember         *User              `json:"new_chat_member,omitempty"`
+	NewChatMembers        []User             `json:"new_chat_members,omitempty"`
+	LeftChatMember        *User              `json:"left_chat_member,omitempty"`
+	NewChatTitle          string             `json:"new_chat_title,omitempty"`
+	NewChatPhoto          []PhotoSize        `json:"new_chat_photo,omitempty"`
+	DeleteChatPhoto       bool               `json:"delete_chat_photo,omitempty"`
+	GroupChatCreated      bool               `json:"group_chat_created,omitempty"`
+	SuperGroupChatCreated bool               `json:"supergroup_chat_created,omitempty"`
+	ChannelChatCreated    bool               `json:"channel_chat_created,omitempty"`
+	MigrateTo             int64              `json:"migrate_to_chat_id,omitempty"`
+	MigrateFrom           int64              `json:"migrate_from_chat_id,omitempty"`
+	Pinned                *Message           `json:"pinned_message,omitempty"`
+	Invoice               *Invoice           `json:"invoice,omitempty"`
+	SuccessfulPayment     *SuccessfulPayment `json:"successful_payment,omitempty"`
+	UsersShared           *UsersShared       `json:"users_shared,omitempty"`
+	ChatShared            *ChatShared        `json:"chat_shared,omitempty"`
+}
+
+// UsersShared contains information about the users whose identifiers were shared with the bot
+// using KeyboardButtonRequestUsers.
+type UsersShared struct {
+	RequestID int          `json:"request_id"`
+	Users     []SharedUser `json:"users"`
+}
+
+// SharedUser contains information about a user shared with the bot, optional fields are
+// available only if requested by KeyboardButtonRequestUsers.
+type SharedUser struct {
+	ID        int64       `json:"user_id"`
+	FirstName string      `json:"first_name,omitempty"`
+	LastName  string      `json:"last_name,omitempty"`
+	Username  string      `json:"username,omitempty"`
+	Photo     []PhotoSize `json:"photo,omitempty"`
+}
+
+// ChatShared contains information about a chat shared with the bot using KeyboardButtonRequestChat,
+// optional fields are available only if requested.
+type ChatShared struct {
+	RequestID int         `json:"request_id"`
+	ID        int64       `json:"chat_id"`
+	Title     string      `json:"title,omitempty"`
+	Username  string      `json:"username,omitempty"`
+	Photo     []PhotoSize `json:"photo,omitempty"`
 }
 
 // EntityText returns array of text, each element represents the text of a message entity
@@ -58,6 +98,27 @@ func (m *Message) EntityText() []string {
 	return ret
 }
 
+// Command returns the bot command (without leading slash and bot username) at the beginning of the message.
+func (m *Message) Command() string {
+	if len(m.Entities) < 1 {
+		return ""
+	}
+	e := m.Entities[0]
+	if e.Type != BotCommandEntity || e.Offset != 0 {
+		return ""
+	}
+
+	runes := []rune(m.Text)
+	if e.Length < 2 || e.Length > len(runes) {
+		return ""
+	}
+	cmd := string(runes[1:e.Length])
+	if idx := strings.Index(cmd, "@"); idx >= 0 {
+		cmd = cmd[:idx]
+	}
+	return cmd
+}
+
 // valid message entity types
 const (
 	MentionEntity     = "mention"
@@ -75,11 +136,20 @@ const (
 
 // MessageEntity represents one special entity in a text message. For example, hashtags, usernames, URLs, etc.
 type MessageEntity struct {
-	Type   string  `json:"type"`
-	Offset int     `json:"offset"`
-	Length int     `json:"length"`
-	URL    string  `json:"url,omitempty"`
-	User   *Victim `json:"user,omitempty"`
+	Type   string `json:"type"`
+	Offset int    `json:"offset"`
+	Length int    `json:"length"`
+	URL    string `json:"url,omitempty"`
+	User   *User  `json:"user,omitempty"`
+}
+
+// LinkPreviewOptions describes the options used for link preview generation.
+type LinkPreviewOptions struct {
+	Disabled         bool   `json:"is_disabled,omitempty"`
+	URL              string `json:"url,omitempty"`
+	PreferSmallMedia bool   `json:"prefer_small_media,omitempty"`
+	PreferLargeMedia bool   `json:"prefer_large_media,omitempty"`
+	ShowAboveText    bool   `json:"show_above_text,omitempty"`
 }
 
 // PhotoSize represents one size of a photo or a file / sticker thumbnail.
@@ -138,6 +208,43 @@ type Voice struct {
 	FileSize int    `json:"file_size,omitempty"`
 }
 
+// Animation represents an animation file (GIF or H.264/MPEG-4 AVC video without sound).
+type Animation struct {
+	FileID   string     `json:"file_id"`
+	Width    int        `json:"width"`
+	Height   int        `json:"height"`
+	Duration int        `json:"duration"`
+	Thumb    *PhotoSize `json:"thumb,omitempty"`
+	FileName string     `json:"file_name,omitempty"`
+	MimeType string     `json:"mime_type,omitempty"`
+	FileSize int        `json:"file_size,omitempty"`
+}
+
+// VideoNote represents a video message.
+type VideoNote struct {
+	FileID   string     `json:"file_id"`
+	Length   int        `json:"length"`
+	Duration int        `json:"duration"`
+	Thumb    *PhotoSize `json:"thumb,omitempty"`
+	FileSize int        `json:"file_size,omitempty"`
+}
+
+// these are valid emoji for dice
+const (
+	DiceEmoji        = "🎲"
+	DartsEmoji       = "🎯"
+	BasketballEmoji  = "🏀"
+	FootballEmoji    = "⚽"
+	BowlingEmoji     = "🎳"
+	SlotMachineEmoji = "🎰"
+)
+
+// Dice represents an animated emoji that displays a random value.
+type Dice struct {
+	Emoji string `json:"emoji"`
+	Value int    `json:"value"`
+}
+
 // Contact represents a phone contact.
 type Contact struct {
 	PhoneNumber string `json:"phone_number"`
@@ -148,8 +255,9 @@ type Contact struct {
 
 // Location represents a point on the map.
 type Location struct {
-	Lat float64 `json:"latitude"`
-	Lng float64 `json:"longitude"`
+	Lat        float64 `json:"latitude"`
+	Lng        float64 `json:"longitude"`
+	LivePeriod int     `json:"live_period,omitempty"`
 }
 
 // Venue represents a venue.
@@ -157,5 +265,41 @@ type Venue struct {
 	Location   *Location `json:"location"`
 	Title      string    `json:"title"`
 	Address    string    `json:"address"`
-	Foursquare string    `json:"fourscuare_id,omitempty"`
+	Foursquare string    `json:"foursquare_id,omitempty"`
+}
+
+// these are valid poll types
+const (
+	RegularPoll = "regular"
+	QuizPoll    = "quiz"
+)
+
+// Poll contains information about a poll.
+type Poll struct {
+	ID                  string          `json:"id"`
+	Question            string          `json:"question"`
+	Options             []PollOption    `json:"options"`
+	TotalVoterCount     int             `json:"total_voter_count"`
+	Closed              bool            `json:"is_closed"`
+	Anonymous           bool            `json:"is_anonymous"`
+	Type                string          `json:"type"`
+	MultipleAnswers     bool            `json:"allows_multiple_answers"`
+	CorrectOption       *int            `json:"correct_option_id,omitempty"` // only in quiz you sent, or closed quiz
+	Explanation         string          `json:"explanation,omitempty"`
+	ExplanationEntities []MessageEntity `json:"explanation_entities,omitempty"`
+	OpenPeriod          int             `json:"open_period,omitempty"`
+	CloseDate           int64           `json:"close_date,omitempty"`
+}
+
+// PollOption contains information about one answer option in a poll.
+type PollOption struct {
+	Text       string `json:"text"`
+	VoterCount int    `json:"voter_count"`
+}
+
+// PollAnswer represents an answer of a user in a non-anonymous poll.
+type PollAnswer struct {
+	PollID  string `json:"poll_id"`
+	User    *User  `json:"user"`
+	Options []int  `json:"option_ids"` // empty if the user retracted the vote
 }
@@ -6,31 +6,189 @@ package telegram
 import (
 	"io"
 	"strconv"
+	"strings"
 )
 
 // Update represents an incoming update.
 type Update struct {
-	ID                 int                 `json:"update_id"`
+	ID                 int64               `json:"update_id"`
 	Message            *Message            `json:"message,omitempty"`
 	EditedMessage      *Message            `json:"edited_message,omitempty"`
 	InlineQuery        *InlineQuery        `json:"inline_query,omitempty"`
 	ChosenInlineResult *ChosenInlineResult `json:"chosen_inline_result,omitempty"`
+	ChannelPost        *Message            `json:"channel_post,omitempty"`
+	EditedChannelPost  *Message            `json:"edited_channel_post,omitempty"`
 	CallbackQuery      *CallbackQuery      `json:"callback_query,omitempty"`
+	ShippingQuery      *ShippingQuery      `json:"shipping_query,omitempty"`
+	PreCheckoutQuery   *PreCheckoutQuery   `json:"pre_checkout_query,omitempty"`
+	Poll               *Poll               `json:"poll,omitempty"`
+	PollAnswer         *PollAnswer         `json:"poll_answer,omitempty"`
+	MyChatMember       *ChatMemberUpdated  `json:"my_chat_member,omitempty"`
+	ChatMember         *ChatMemberUpdated  `json:"chat_member,omitempty"`
+	ChatJoinRequest    *ChatJoinRequest    `json:"chat_join_request,omitempty"`
+}
+
+// ChatID identifies the target chat, which can be numeric id or "@username" of a supergroup or channel
+type ChatID string
+
+// ID creates ChatID from numeric id of user or chat
+func ID(id int64) ChatID {
+	return ChatID(strconv.FormatInt(id, 10))
+}
+
+// Username creates ChatID from username of a supergroup or channel, prefixing "@" if needed
+func Username(name string) ChatID {
+	if strings.HasPrefix(name, "@") {
+		return ChatID(name)
+	}
+	return ChatID("@" + name)
+}
+
+// IsUsername reports whether the ChatID is in "@username" form
+func (c ChatID) IsUsername() bool {
+	return strings.HasPrefix(string(c), "@")
+}
+
+// Int64 returns numeric id, ok is false if the ChatID is not numeric
+func (c ChatID) Int64() (id int64, ok bool) {
+	id, err := strconv.ParseInt(string(c), 10, 64)
+	return id, err == nil
+}
+
+// ChatType represents 4 kinds of chat
+type ChatType string
+
+// these are valid chat types
+const (
+	ChatPrivate    ChatType = "private"
+	ChatGroup      ChatType = "group"
+	ChatSuperGroup ChatType = "supergroup"
+	ChatChannel    ChatType = "channel"
+)
+
+// User represents a Telegram user or bot.
+type User struct {
+	ID                      int64  `json:"id"`
+	IsBot                   bool   `json:"is_bot"`
+	FirstName               string `json:"first_name"`
+	LastName                string `json:"last_name,omitempty"`
+	Username                string `json:"username,omitempty"`
+	LanguageCode            string `json:"language_code,omitempty"`
+	IsPremium               bool   `json:"is_premium,omitempty"`
+	CanJoinGroups           bool   `json:"can_join_groups,omitempty"`             // returned only in GetMe
+	CanReadAllGroupMessages bool   `json:"can_read_all_group_messages,omitempty"` // returned only in GetMe
+	SupportsInlineQueries   bool   `json:"supports_inline_queries,omitempty"`     // returned only in GetMe
+}
+
+// Identifier returns string representation of identifier, which is always User.ID
+func (u User) Identifier() string {
+	return strconv.FormatInt(u.ID, 10)
+}
+
+// ChatID returns ChatID of the private chat with this user
+func (u User) ChatID() ChatID {
+	return ID(u.ID)
+}
+
+// Victim converts User to Victim, for compatibility purpose
+func (u User) Victim() Victim {
+	return Victim{
+		ID:        u.ID,
+		Type:      VTypeUser,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Username:  u.Username,
+	}
+}
+
+// Chat represents a chat.
+type Chat struct {
+	ID        int64    `json:"id"`
+	Type      ChatType `json:"type"`
+	Title     string   `json:"title,omitempty"`
+	Username  string   `json:"username,omitempty"`
+	FirstName string   `json:"first_name,omitempty"`
+	LastName  string   `json:"last_name,omitempty"`
+	IsForum   bool     `json:"is_forum,omitempty"`
+}
+
+// Identifier returns string representation of identifier.
+//
+// For supergroup and channel with username, it will be Chat.Username prefixes with "@".
+// For others, it will be Chat.ID.
+func (c Chat) Identifier() string {
+	if (c.Type == ChatSuperGroup || c.Type == ChatChannel) && c.Username != "" {
+		return "@" + c.Username
+	}
+	return strconv.FormatInt(c.ID, 10)
+}
+
+// ChatID returns numeric ChatID of this chat, which never changes unlike username
+func (c Chat) ChatID() ChatID {
+	return ID(c.ID)
+}
+
+// Victim converts Chat to Victim, for compatibility purpose
+func (c Chat) Victim() Victim {
+	return Victim{
+		ID:        c.ID,
+		Type:      VictimType(c.Type),
+		Title:     c.Title,
+		FirstName: c.FirstName,
+		LastName:  c.LastName,
+		Username:  c.Username,
+	}
+}
+
+// ChatFullInfo contains full information about a chat, returned by GetChat
+type ChatFullInfo struct {
+	Chat
+	Photo                 *ChatPhoto       `json:"photo,omitempty"`
+	ActiveUsernames       []string         `json:"active_usernames,omitempty"`
+	Bio                   string           `json:"bio,omitempty"`
+	Description           string           `json:"description,omitempty"`
+	InviteLink            string           `json:"invite_link,omitempty"`
+	PinnedMessage         *Message         `json:"pinned_message,omitempty"`
+	Permissions           *ChatPermissions `json:"permissions,omitempty"`
+	SlowModeDelay         int              `json:"slow_mode_delay,omitempty"`
+	MessageAutoDeleteTime int              `json:"message_auto_delete_time,omitempty"`
+	HasProtectedContent   bool             `json:"has_protected_content,omitempty"`
+	StickerSetName        string           `json:"sticker_set_name,omitempty"`
+	LinkedChatID          int64            `json:"linked_chat_id,omitempty"`
+	Location              *ChatLocation    `json:"location,omitempty"`
+}
+
+// ChatPhoto represents a chat photo.
+type ChatPhoto struct {
+	SmallFileID       string `json:"small_file_id"`
+	SmallFileUniqueID string `json:"small_file_unique_id"`
+	BigFileID         string `json:"big_file_id"`
+	BigFileUniqueID   string `json:"big_file_unique_id"`
+}
+
+// ChatLocation represents a location to which a chat is connected.
+type ChatLocation struct {
+	Location *Location `json:"location"`
+	Address  string    `json:"address"`
 }
 
 // VictimType represents 5 kinds of valid receiver
+//
+// Deprecated: use ChatType instead.
 type VictimType string
 
 // these are valid receiver types
 const (
 	VTypeUser       VictimType = ""
-	VTypePrivate    VictimType = "private"
-	VTypeGroup      VictimType = "group"
-	VTypeSuperGroup VictimType = "supergroup"
-	VTypeChannel    VictimType = "channel"
+	VTypePrivate    VictimType = VictimType(ChatPrivate)
+	VTypeGroup      VictimType = VictimType(ChatGroup)
+	VTypeSuperGroup VictimType = VictimType(ChatSuperGroup)
+	VTypeChannel    VictimType = VictimType(ChatChannel)
 )
 
 // Victim represents a valid receiver, which can be a user or a chat
+//
+// Deprecated: use User or Chat instead. Both of them can be converted to Victim by their Victim method.
 type Victim struct {
 	ID        int64      `json:"id"`             // use int64 to compatible with chat
 	Type      VictimType `json:"type,omitempty"` // omit if is user
@@ -42,15 +200,20 @@ type Victim struct {
 
 // Identifier returns string representation of identifier.
 //
-// For user, private chat and group chat, it will be Victim.ID.
-// For others, it will be Victim.Username prefixes with "@"
+// For supergroup and channel with username, it will be Victim.Username prefixes with "@".
+// For others, it will be Victim.ID.
 func (v Victim) Identifier() string {
-	if v.Type == VTypeSuperGroup || v.Type == VTypeChannel {
+	if (v.Type == VTypeSuperGroup || v.Type == VTypeChannel) && v.Username != "" {
 		return "@" + v.Username
 	}
 	return strconv.FormatInt(v.ID, 10)
 }
 
+// ChatID returns numeric ChatID of this victim
+func (v Victim) ChatID() ChatID {
+	return ID(v.ID)
+}
+
 // UserProfilePhotos represents a user's profile picture.
 type UserProfilePhotos struct {
 	Length int           `json:"total_count"`
@@ -71,21 +234,163 @@ type File struct {
 	Path string `json:"file_path,omitempty"`
 }
 
-// ChatMember contains information about pne member of the chat.
+// ChatMember contains information about one member of the chat.
+//
+// Fields other than User and Status are filled only for some statuses:
+// administrator rights for creator and administrator, permissions for restricted,
+// UntilDate for restricted and kicked.
 type ChatMember struct {
-	User   *Victim `json:"user"`
-	Status string  `json:"status"`
+	User        *User  `json:"user"`
+	Status      string `json:"status"`
+	CustomTitle string `json:"custom_title,omitempty"`
+	Anonymous   bool   `json:"is_anonymous,omitempty"`
+	UntilDate   int64  `json:"until_date,omitempty"` // 0 means forever
+	IsMember    bool   `json:"is_member,omitempty"`  // restricted only
+
+	// administrator rights
+	CanBeEdited         bool `json:"can_be_edited,omitempty"`
+	CanManageChat       bool `json:"can_manage_chat,omitempty"`
+	CanDeleteMessages   bool `json:"can_delete_messages,omitempty"`
+	CanManageVideoChats bool `json:"can_manage_video_chats,omitempty"`
+	CanRestrictMembers  bool `json:"can_restrict_members,omitempty"`
+	CanPromoteMembers   bool `json:"can_promote_members,omitempty"`
+	CanPostMessages     bool `json:"can_post_messages,omitempty"`
+	CanEditMessages     bool `json:"can_edit_messages,omitempty"`
+
+	// shared by administrator rights and permissions
+	CanChangeInfo   bool `json:"can_change_info,omitempty"`
+	CanInviteUsers  bool `json:"can_invite_users,omitempty"`
+	CanPinMessages  bool `json:"can_pin_messages,omitempty"`
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+
+	// permissions
+	CanSendMessages       bool `json:"can_send_messages,omitempty"`
+	CanSendAudios         bool `json:"can_send_audios,omitempty"`
+	CanSendDocuments      bool `json:"can_send_documents,omitempty"`
+	CanSendPhotos         bool `json:"can_send_photos,omitempty"`
+	CanSendVideos         bool `json:"can_send_videos,omitempty"`
+	CanSendVideoNotes     bool `json:"can_send_video_notes,omitempty"`
+	CanSendVoiceNotes     bool `json:"can_send_voice_notes,omitempty"`
+	CanSendPolls          bool `json:"can_send_polls,omitempty"`
+	CanSendOtherMessages  bool `json:"can_send_other_messages,omitempty"`
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"`
+}
+
+// Rights extracts administrator rights of this member
+func (m ChatMember) Rights() ChatAdministratorRights {
+	return ChatAdministratorRights{
+		Anonymous:           m.Anonymous,
+		CanManageChat:       m.CanManageChat,
+		CanDeleteMessages:   m.CanDeleteMessages,
+		CanManageVideoChats: m.CanManageVideoChats,
+		CanRestrictMembers:  m.CanRestrictMembers,
+		CanPromoteMembers:   m.CanPromoteMembers,
+		CanChangeInfo:       m.CanChangeInfo,
+		CanInviteUsers:      m.CanInviteUsers,
+		CanPostMessages:     m.CanPostMessages,
+		CanEditMessages:     m.CanEditMessages,
+		CanPinMessages:      m.CanPinMessages,
+		CanManageTopics:     m.CanManageTopics,
+	}
+}
+
+// Permissions extracts permissions of this member, only meaningful for restricted member
+func (m ChatMember) Permissions() ChatPermissions {
+	return ChatPermissions{
+		CanSendMessages:       m.CanSendMessages,
+		CanSendAudios:         m.CanSendAudios,
+		CanSendDocuments:      m.CanSendDocuments,
+		CanSendPhotos:         m.CanSendPhotos,
+		CanSendVideos:         m.CanSendVideos,
+		CanSendVideoNotes:     m.CanSendVideoNotes,
+		CanSendVoiceNotes:     m.CanSendVoiceNotes,
+		CanSendPolls:          m.CanSendPolls,
+		CanSendOtherMessages:  m.CanSendOtherMessages,
+		CanAddWebPagePreviews: m.CanAddWebPagePreviews,
+		CanChangeInfo:         m.CanChangeInfo,
+		CanInviteUsers:        m.CanInviteUsers,
+		CanPinMessages:        m.CanPinMessages,
+		CanManageTopics:       m.CanManageTopics,
+	}
 }
 
 // these are valid member status
 const (
-	MStatusCreator = "creator"
-	MStatusAdmin   = "administrator"
-	MStatusMember  = "member"
-	MStatusLeft    = "left"
-	MStatusKicked  = "kicked"
+	MStatusCreator    = "creator"
+	MStatusAdmin      = "administrator"
+	MStatusMember     = "member"
+	MStatusRestricted = "restricted"
+	MStatusLeft       = "left"
+	MStatusKicked     = "kicked"
 )
 
+// ChatMemberUpdated represents changes in the status of a chat member.
+type ChatMemberUpdated struct {
+	Chat       *Chat           `json:"chat"`
+	From       *User           `json:"from"`
+	Timestamp  int64           `json:"date"`
+	Old        ChatMember      `json:"old_chat_member"`
+	New        ChatMember      `json:"new_chat_member"`
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+}
+
+// ChatJoinRequest represents a join request sent to a chat.
+type ChatJoinRequest struct {
+	Chat       *Chat           `json:"chat"`
+	From       *User           `json:"from"`
+	UserChatID int64           `json:"user_chat_id"`
+	Timestamp  int64           `json:"date"`
+	Bio        string          `json:"bio,omitempty"`
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+}
+
+// ChatPermissions describes actions that a non-administrator user is allowed to take in a chat.
+type ChatPermissions struct {
+	CanSendMessages       bool `json:"can_send_messages,omitempty"`
+	CanSendAudios         bool `json:"can_send_audios,omitempty"`
+	CanSendDocuments      bool `json:"can_send_documents,omitempty"`
+	CanSendPhotos         bool `json:"can_send_photos,omitempty"`
+	CanSendVideos         bool `json:"can_send_videos,omitempty"`
+	CanSendVideoNotes     bool `json:"can_send_video_notes,omitempty"`
+	CanSendVoiceNotes     bool `json:"can_send_voice_notes,omitempty"`
+	CanSendPolls          bool `json:"can_send_polls,omitempty"`
+	CanSendOtherMessages  bool `json:"can_send_other_messages,omitempty"`
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"`
+	CanChangeInfo         bool `json:"can_change_info,omitempty"`
+	CanInviteUsers        bool `json:"can_invite_users,omitempty"`
+	CanPinMessages        bool `json:"can_pin_messages,omitempty"`
+	CanManageTopics       bool `json:"can_manage_topics,omitempty"`
+}
+
+// ChatAdministratorRights represents the rights of an administrator in a chat.
+type ChatAdministratorRights struct {
+	Anonymous           bool `json:"is_anonymous,omitempty"`
+	CanManageChat       bool `json:"can_manage_chat,omitempty"`
+	CanDeleteMessages   bool `json:"can_delete_messages,omitempty"`
+	CanManageVideoChats bool `json:"can_manage_video_chats,omitempty"`
+	CanRestrictMembers  bool `json:"can_restrict_members,omitempty"`
+	CanPromoteMembers   bool `json:"can_promote_members,omitempty"`
+	CanChangeInfo       bool `json:"can_change_info,omitempty"`
+	CanInviteUsers      bool `json:"can_invite_users,omitempty"`
+	CanPostMessages     bool `json:"can_post_messages,omitempty"` // channels only
+	CanEditMessages     bool `json:"can_edit_messages,omitempty"` // channels only
+	CanPinMessages      bool `json:"can_pin_messages,omitempty"`
+	CanManageTopics     bool `json:"can_manage_topics,omitempty"` // supergroups only
+}
+
+// ChatInviteLink represents an invite link for a chat.
+type ChatInviteLink struct {
+	Link                    string `json:"invite_link"`
+	Creator                 *User  `json:"creator"`
+	JoinRequest             bool   `json:"creates_join_request"`
+	Primary                 bool   `json:"is_primary"`
+	Revoked                 bool   `json:"is_revoked"`
+	Name                    string `json:"name,omitempty"`
+	ExpireDate              int64  `json:"expire_date,omitempty"`
+	MemberLimit             int    `json:"member_limit,omitempty"`
+	PendingJoinRequestCount int    `json:"pending_join_request_count,omitempty"`
+}
+
 // InputFile represents the contents of a file to be uploaded.
 // you should use existing file if InputFile.FileID exists.
 type InputFile struct {
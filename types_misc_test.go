@@ -0,0 +1,38 @@
+// This file is part of Camponotus
+// Camponotus is free software: see LICENSE.txt for more details.
+
+package telegram
+
+import "testing"
+
+func TestChatIdentifier(t *testing.T) {
+	tbl := []struct {
+		chat   Chat
+		expect string
+	}{
+		{Chat{ID: 1, Type: ChatPrivate, Username: "user"}, "1"},
+		{Chat{ID: -2, Type: ChatGroup}, "-2"},
+		{Chat{ID: -1003, Type: ChatSuperGroup, Username: "super"}, "@super"},
+		{Chat{ID: -1004, Type: ChatSuperGroup}, "-1004"},
+		{Chat{ID: -1005, Type: ChatChannel, Username: "channel"}, "@channel"},
+		{Chat{ID: -1006, Type: ChatChannel}, "-1006"},
+	}
+
+	for _, data := range tbl {
+		if actual := data.chat.Identifier(); actual != data.expect {
+			t.Errorf("Identifier of %#v should be %s, got %s", data.chat, data.expect, actual)
+		}
+	}
+}
+
+func TestChatID(t *testing.T) {
+	if id, ok := ID(-1001234567890).Int64(); !ok || id != -1001234567890 {
+		t.Errorf("expected numeric chat id -1001234567890, got %d (%t)", id, ok)
+	}
+	if _, ok := Username("channel").Int64(); ok {
+		t.Error("username should not be numeric chat id")
+	}
+	if c := Username("@channel"); c != "@channel" || !c.IsUsername() {
+		t.Errorf("expected @channel, got %s", c)
+	}
+}
@@ -4,16 +4,62 @@
 package telegram
 
 import (
+	"encoding/json"
 	"io"
 	"net/url"
 )
 
+// these are valid update types for allowed_updates
+const (
+	UpdateMessage            = "message"
+	UpdateEditedMessage      = "edited_message"
+	UpdateChannelPost        = "channel_post"
+	UpdateEditedChannelPost  = "edited_channel_post"
+	UpdateInlineQuery        = "inline_query"
+	UpdateChosenInlineResult = "chosen_inline_result"
+	UpdateCallbackQuery      = "callback_query"
+	UpdateShippingQuery      = "shipping_query"
+	UpdatePreCheckoutQuery   = "pre_checkout_query"
+	UpdatePoll               = "poll"
+	UpdatePollAnswer         = "poll_answer"
+	UpdateMyChatMember       = "my_chat_member"
+	UpdateChatMember         = "chat_member"
+	UpdateChatJoinRequest    = "chat_join_request"
+)
+
+// AllUpdates lists all update types supported by this package
+var AllUpdates = []string{
+	UpdateMessage,
+	UpdateEditedMessage,
+	UpdateChannelPost,
+	UpdateEditedChannelPost,
+	UpdateInlineQuery,
+	UpdateChosenInlineResult,
+	UpdateCallbackQuery,
+	UpdateShippingQuery,
+	UpdatePreCheckoutQuery,
+	UpdatePoll,
+	UpdatePollAnswer,
+	UpdateMyChatMember,
+	UpdateChatMember,
+	UpdateChatJoinRequest,
+}
+
 // GetUpdates maps to https://core.telegram.org/bots/api#getupdates
-func (a *api) GetUpdates(offset, limit, timeout int) ([]Update, error) {
+//
+// Pass nil as allowed to use previous setting, or an empty slice to receive all updates except chat_member.
+func (a *api) GetUpdates(offset int64, limit, timeout int, allowed []string) ([]Update, error) {
 	params := url.Values{}
-	optInt(params, "offset", offset)
+	optInt64(params, "offset", offset)
 	optInt(params, "limit", limit)
 	optInt(params, "timeout", timeout)
+	if allowed != nil {
+		buf, err := json.Marshal(allowed)
+		if err != nil {
+			return nil, err
+		}
+		params.Set("allowed_updates", string(buf))
+	}
 
 	var u updateResult
 	err := a.callAndSet("getUpdates", params, &u)